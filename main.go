@@ -1,12 +1,33 @@
 package main
 
 import (
-	"github.com/hashicorp/terraform-plugin-sdk/v2/plugin"
+	"context"
+	"log"
+
 	"github.com/autoritas-ai/terraform-provider-elevenlabs/provider"
+	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5/tf5server"
+	"github.com/hashicorp/terraform-plugin-mux/tf5muxserver"
 )
 
 func main() {
-	plugin.Serve(&plugin.ServeOpts{
-		ProviderFunc: provider.Provider,
-	})
-}
\ No newline at end of file
+	ctx := context.Background()
+
+	// The SDKv2 provider serves resources and data sources, the framework
+	// provider serves ephemeral resources and functions.
+	providers := []func() tfprotov5.ProviderServer{
+		provider.Provider().GRPCProvider,
+		providerserver.NewProtocol5(provider.NewFrameworkProvider()),
+	}
+
+	muxServer, err := tf5muxserver.NewMuxServer(ctx, providers...)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	err = tf5server.Serve("registry.terraform.io/autoritas-ai/elevenlabs", muxServer.ProviderServer)
+	if err != nil {
+		log.Fatal(err)
+	}
+}
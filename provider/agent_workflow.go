@@ -0,0 +1,378 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+var workflowNodeTypes = []string{
+	"start",
+	"end",
+	"override_agent",
+	"standalone_agent",
+	"tool",
+	"phone_number",
+}
+
+func workflowSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		MaxItems: 1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"node": {
+					Type:     schema.TypeSet,
+					Required: true,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"id": {
+								Type:     schema.TypeString,
+								Required: true,
+							},
+							"type": {
+								Type:         schema.TypeString,
+								Required:     true,
+								ValidateFunc: validation.StringInSlice(workflowNodeTypes, false),
+							},
+							"label": {
+								Type:     schema.TypeString,
+								Optional: true,
+							},
+							"position_x": {
+								Type:     schema.TypeFloat,
+								Optional: true,
+							},
+							"position_y": {
+								Type:     schema.TypeFloat,
+								Optional: true,
+							},
+							"additional_prompt": {
+								Type:        schema.TypeString,
+								Optional:    true,
+								Description: "Prompt appended to the agent's prompt while an `override_agent` node is active.",
+							},
+							"tool_ids": {
+								Type:        schema.TypeList,
+								Optional:    true,
+								Description: "Tools made available by an `override_agent` node, or run by a `tool` node.",
+								Elem:        &schema.Schema{Type: schema.TypeString},
+							},
+							"agent_id": {
+								Type:        schema.TypeString,
+								Optional:    true,
+								Description: "The agent a `standalone_agent` node transfers the conversation to.",
+							},
+							"transfer_message": {
+								Type:     schema.TypeString,
+								Optional: true,
+							},
+							"phone_number": {
+								Type:        schema.TypeString,
+								Optional:    true,
+								Description: "The number a `phone_number` node transfers the call to.",
+							},
+						},
+					},
+				},
+				"edge": {
+					Type:     schema.TypeSet,
+					Optional: true,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"id": {
+								Type:     schema.TypeString,
+								Required: true,
+							},
+							"source": {
+								Type:     schema.TypeString,
+								Required: true,
+							},
+							"target": {
+								Type:     schema.TypeString,
+								Required: true,
+							},
+							"condition_type": {
+								Type:         schema.TypeString,
+								Optional:     true,
+								Default:      "unconditional",
+								ValidateFunc: validation.StringInSlice([]string{"unconditional", "llm", "result"}, false),
+							},
+							"condition": {
+								Type:        schema.TypeString,
+								Optional:    true,
+								Description: "The condition the LLM evaluates to follow an `llm` edge.",
+							},
+							"successful": {
+								Type:        schema.TypeBool,
+								Optional:    true,
+								Description: "Whether a `result` edge is followed when the source tool succeeds or when it fails.",
+							},
+							"label": {
+								Type:     schema.TypeString,
+								Optional: true,
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func expandWorkflow(workflowData map[string]interface{}) *Workflow {
+	workflow := &Workflow{
+		Nodes: make(map[string]*WorkflowNode),
+		Edges: make(map[string]*WorkflowEdge),
+	}
+
+	edgeOrder := make(map[string][]string)
+	if edgeSet, ok := workflowData["edge"].(*schema.Set); ok {
+		for _, item := range edgeSet.List() {
+			edgeData := item.(map[string]interface{})
+			id := edgeData["id"].(string)
+			condition := &WorkflowCondition{
+				Type:  edgeData["condition_type"].(string),
+				Label: edgeData["label"].(string),
+			}
+			switch condition.Type {
+			case "llm":
+				condition.Condition = edgeData["condition"].(string)
+			case "result":
+				v := edgeData["successful"].(bool)
+				condition.Successful = &v
+			}
+			workflow.Edges[id] = &WorkflowEdge{
+				Source:           edgeData["source"].(string),
+				Target:           edgeData["target"].(string),
+				ForwardCondition: condition,
+			}
+			edgeOrder[edgeData["source"].(string)] = append(edgeOrder[edgeData["source"].(string)], id)
+		}
+	}
+
+	if nodeSet, ok := workflowData["node"].(*schema.Set); ok {
+		for _, item := range nodeSet.List() {
+			nodeData := item.(map[string]interface{})
+			id := nodeData["id"].(string)
+			node := &WorkflowNode{
+				Type:  nodeData["type"].(string),
+				Label: nodeData["label"].(string),
+				Position: &WorkflowNodePosition{
+					X: nodeData["position_x"].(float64),
+					Y: nodeData["position_y"].(float64),
+				},
+			}
+			if edges := edgeOrder[id]; len(edges) > 0 {
+				sort.Strings(edges)
+				node.EdgeOrder = edges
+			}
+
+			var toolIDs []string
+			for _, toolID := range nodeData["tool_ids"].([]interface{}) {
+				toolIDs = append(toolIDs, toolID.(string))
+			}
+
+			switch node.Type {
+			case "override_agent":
+				node.AdditionalPrompt = nodeData["additional_prompt"].(string)
+				node.AdditionalToolIDs = toolIDs
+			case "tool":
+				for _, toolID := range toolIDs {
+					node.Tools = append(node.Tools, &WorkflowToolLocator{ToolID: toolID})
+				}
+			case "standalone_agent":
+				node.AgentID = nodeData["agent_id"].(string)
+				node.TransferMessage = nodeData["transfer_message"].(string)
+			case "phone_number":
+				node.TransferMessage = nodeData["transfer_message"].(string)
+				node.TransferDestination = &PhoneTransferDestination{
+					Type:        "phone",
+					PhoneNumber: nodeData["phone_number"].(string),
+				}
+			}
+			workflow.Nodes[id] = node
+		}
+	}
+
+	return workflow
+}
+
+func flattenWorkflow(workflow *Workflow) []interface{} {
+	nodeList := make([]interface{}, 0, len(workflow.Nodes))
+	for id, node := range workflow.Nodes {
+		nodeMap := make(map[string]interface{})
+		nodeMap["id"] = id
+		nodeMap["type"] = node.Type
+		nodeMap["label"] = node.Label
+		if node.Position != nil {
+			nodeMap["position_x"] = node.Position.X
+			nodeMap["position_y"] = node.Position.Y
+		}
+		nodeMap["additional_prompt"] = node.AdditionalPrompt
+		nodeMap["agent_id"] = node.AgentID
+		nodeMap["transfer_message"] = node.TransferMessage
+		if node.TransferDestination != nil {
+			nodeMap["phone_number"] = node.TransferDestination.PhoneNumber
+		}
+		toolIDs := append([]string{}, node.AdditionalToolIDs...)
+		for _, tool := range node.Tools {
+			toolIDs = append(toolIDs, tool.ToolID)
+		}
+		nodeMap["tool_ids"] = toolIDs
+		nodeList = append(nodeList, nodeMap)
+	}
+
+	edgeList := make([]interface{}, 0, len(workflow.Edges))
+	for id, edge := range workflow.Edges {
+		edgeMap := make(map[string]interface{})
+		edgeMap["id"] = id
+		edgeMap["source"] = edge.Source
+		edgeMap["target"] = edge.Target
+		edgeMap["condition_type"] = "unconditional"
+		if edge.ForwardCondition != nil {
+			edgeMap["condition_type"] = edge.ForwardCondition.Type
+			edgeMap["condition"] = edge.ForwardCondition.Condition
+			edgeMap["label"] = edge.ForwardCondition.Label
+			if edge.ForwardCondition.Successful != nil {
+				edgeMap["successful"] = *edge.ForwardCondition.Successful
+			}
+		}
+		edgeList = append(edgeList, edgeMap)
+	}
+
+	workflowMap := map[string]interface{}{
+		"node": nodeList,
+		"edge": edgeList,
+	}
+	return []interface{}{workflowMap}
+}
+
+// validateAgentWorkflow checks the workflow graph at plan time: it must have
+// exactly one start node, every edge must join existing nodes, and every
+// node must be reachable from the start node. The check reads the raw
+// configuration so that values only known after apply, such as the ID of an
+// agent created in the same plan, are skipped rather than read as empty.
+func validateAgentWorkflow(ctx context.Context, d *schema.ResourceDiff, m interface{}) error {
+	workflowData, ok := rawConfigInterface(rawConfigValue(d.GetRawConfig(), "workflow", 0)).(map[string]interface{})
+	if !ok {
+		return nil
+	}
+	nodes, ok := workflowData["node"].([]interface{})
+	if !ok || len(nodes) == 0 {
+		return nil
+	}
+	edges, ok := workflowData["edge"].([]interface{})
+	if !ok {
+		return nil
+	}
+	return validateWorkflowGraph(nodes, edges)
+}
+
+// validateWorkflowGraph validates workflow nodes and edges given as
+// attribute maps, where nil stands for a value that is not known yet. The
+// graph checks are skipped when a node or edge ID, node type or edge
+// endpoint is unknown.
+func validateWorkflowGraph(nodeList, edgeList []interface{}) error {
+	nodes := make(map[string]string)
+	var nodeIDs []string
+	start := ""
+	for _, item := range nodeList {
+		nodeData := item.(map[string]interface{})
+		id, ok := nodeData["id"].(string)
+		if !ok {
+			return nil
+		}
+		nodeType, ok := nodeData["type"].(string)
+		if !ok {
+			return nil
+		}
+		if _, ok := nodes[id]; ok {
+			return fmt.Errorf("workflow: duplicate node id %q", id)
+		}
+		nodes[id] = nodeType
+		nodeIDs = append(nodeIDs, id)
+
+		if nodeType == "start" {
+			if start != "" {
+				return fmt.Errorf("workflow: nodes %q and %q are both start nodes; a workflow has exactly one", start, id)
+			}
+			start = id
+		}
+		if err := validateWorkflowNode(id, nodeType, nodeData); err != nil {
+			return err
+		}
+	}
+	if start == "" {
+		return fmt.Errorf("workflow: missing a node of type \"start\"")
+	}
+
+	adjacency := make(map[string][]string)
+	edgeIDs := make(map[string]bool)
+	for _, item := range edgeList {
+		edgeData := item.(map[string]interface{})
+		id, idKnown := edgeData["id"].(string)
+		source, sourceKnown := edgeData["source"].(string)
+		target, targetKnown := edgeData["target"].(string)
+		if !idKnown || !sourceKnown || !targetKnown {
+			return nil
+		}
+		if edgeIDs[id] {
+			return fmt.Errorf("workflow: duplicate edge id %q", id)
+		}
+		edgeIDs[id] = true
+		if _, ok := nodes[source]; !ok {
+			return fmt.Errorf("workflow: edge %q has unknown source node %q", id, source)
+		}
+		if _, ok := nodes[target]; !ok {
+			return fmt.Errorf("workflow: edge %q has unknown target node %q", id, target)
+		}
+		if edgeData["condition_type"] == "llm" && edgeData["condition"] == "" {
+			return fmt.Errorf("workflow: edge %q needs a condition when condition_type is \"llm\"", id)
+		}
+		adjacency[source] = append(adjacency[source], target)
+	}
+
+	reached := map[string]bool{start: true}
+	queue := []string{start}
+	for len(queue) > 0 {
+		current := queue[0]
+		queue = queue[1:]
+		for _, next := range adjacency[current] {
+			if !reached[next] {
+				reached[next] = true
+				queue = append(queue, next)
+			}
+		}
+	}
+	sort.Strings(nodeIDs)
+	for _, id := range nodeIDs {
+		if !reached[id] {
+			return fmt.Errorf("workflow: node %q is not reachable from start node %q", id, start)
+		}
+	}
+
+	return nil
+}
+
+func validateWorkflowNode(id, nodeType string, nodeData map[string]interface{}) error {
+	switch nodeType {
+	case "standalone_agent":
+		if nodeData["agent_id"] == "" {
+			return fmt.Errorf("workflow: node %q of type \"standalone_agent\" requires agent_id", id)
+		}
+	case "phone_number":
+		if nodeData["phone_number"] == "" {
+			return fmt.Errorf("workflow: node %q of type \"phone_number\" requires phone_number", id)
+		}
+	case "tool":
+		if toolIDs, ok := nodeData["tool_ids"].([]interface{}); ok && len(toolIDs) == 0 {
+			return fmt.Errorf("workflow: node %q of type \"tool\" requires at least one entry in tool_ids", id)
+		}
+	}
+	return nil
+}
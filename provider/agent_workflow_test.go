@@ -0,0 +1,204 @@
+package provider
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/go-cty/cty"
+)
+
+func workflowTestNode(id, nodeType string, attrs map[string]interface{}) interface{} {
+	node := map[string]interface{}{
+		"id":           id,
+		"type":         nodeType,
+		"agent_id":     "",
+		"phone_number": "",
+		"tool_ids":     []interface{}{},
+	}
+	for k, v := range attrs {
+		node[k] = v
+	}
+	return node
+}
+
+func workflowTestEdge(id, source, target string, attrs map[string]interface{}) interface{} {
+	edge := map[string]interface{}{
+		"id":             id,
+		"source":         source,
+		"target":         target,
+		"condition_type": "unconditional",
+		"condition":      "",
+	}
+	for k, v := range attrs {
+		edge[k] = v
+	}
+	return edge
+}
+
+func TestValidateWorkflowGraph(t *testing.T) {
+	cases := []struct {
+		name    string
+		nodes   []interface{}
+		edges   []interface{}
+		wantErr string
+	}{
+		{
+			name: "valid",
+			nodes: []interface{}{
+				workflowTestNode("start", "start", nil),
+				workflowTestNode("support", "override_agent", nil),
+				workflowTestNode("end", "end", nil),
+			},
+			edges: []interface{}{
+				workflowTestEdge("e1", "start", "support", map[string]interface{}{"condition_type": "llm", "condition": "needs help"}),
+				workflowTestEdge("e2", "support", "end", nil),
+			},
+		},
+		{
+			name:    "missing start",
+			nodes:   []interface{}{workflowTestNode("end", "end", nil)},
+			wantErr: `missing a node of type "start"`,
+		},
+		{
+			name: "two start nodes",
+			nodes: []interface{}{
+				workflowTestNode("a", "start", nil),
+				workflowTestNode("b", "start", nil),
+			},
+			wantErr: "are both start nodes",
+		},
+		{
+			name: "duplicate node",
+			nodes: []interface{}{
+				workflowTestNode("start", "start", nil),
+				workflowTestNode("start", "end", nil),
+			},
+			wantErr: `duplicate node id "start"`,
+		},
+		{
+			name: "duplicate edge",
+			nodes: []interface{}{
+				workflowTestNode("start", "start", nil),
+				workflowTestNode("end", "end", nil),
+			},
+			edges: []interface{}{
+				workflowTestEdge("e1", "start", "end", nil),
+				workflowTestEdge("e1", "start", "end", nil),
+			},
+			wantErr: `duplicate edge id "e1"`,
+		},
+		{
+			name:    "unknown source",
+			nodes:   []interface{}{workflowTestNode("start", "start", nil)},
+			edges:   []interface{}{workflowTestEdge("e1", "nowhere", "start", nil)},
+			wantErr: `unknown source node "nowhere"`,
+		},
+		{
+			name:    "unknown target",
+			nodes:   []interface{}{workflowTestNode("start", "start", nil)},
+			edges:   []interface{}{workflowTestEdge("e1", "start", "nowhere", nil)},
+			wantErr: `unknown target node "nowhere"`,
+		},
+		{
+			name: "llm edge without condition",
+			nodes: []interface{}{
+				workflowTestNode("start", "start", nil),
+				workflowTestNode("end", "end", nil),
+			},
+			edges:   []interface{}{workflowTestEdge("e1", "start", "end", map[string]interface{}{"condition_type": "llm"})},
+			wantErr: `edge "e1" needs a condition`,
+		},
+		{
+			name: "unreachable node",
+			nodes: []interface{}{
+				workflowTestNode("start", "start", nil),
+				workflowTestNode("end", "end", nil),
+			},
+			wantErr: `node "end" is not reachable`,
+		},
+		{
+			name: "standalone agent without agent_id",
+			nodes: []interface{}{
+				workflowTestNode("start", "start", nil),
+				workflowTestNode("sales", "standalone_agent", nil),
+			},
+			wantErr: "requires agent_id",
+		},
+		{
+			name: "phone number without number",
+			nodes: []interface{}{
+				workflowTestNode("start", "start", nil),
+				workflowTestNode("call", "phone_number", nil),
+			},
+			wantErr: "requires phone_number",
+		},
+		{
+			name: "tool node without tools",
+			nodes: []interface{}{
+				workflowTestNode("start", "start", nil),
+				workflowTestNode("lookup", "tool", nil),
+			},
+			wantErr: "requires at least one entry in tool_ids",
+		},
+		{
+			name: "unknown node attributes",
+			nodes: []interface{}{
+				workflowTestNode("start", "start", nil),
+				workflowTestNode("sales", "standalone_agent", map[string]interface{}{"agent_id": nil}),
+				workflowTestNode("lookup", "tool", map[string]interface{}{"tool_ids": nil}),
+			},
+			edges: []interface{}{
+				workflowTestEdge("e1", "start", "sales", nil),
+				workflowTestEdge("e2", "start", "lookup", map[string]interface{}{"condition_type": "llm", "condition": nil}),
+			},
+		},
+		{
+			name: "unknown edge target",
+			nodes: []interface{}{
+				workflowTestNode("start", "start", nil),
+				workflowTestNode("end", "end", nil),
+			},
+			edges: []interface{}{workflowTestEdge("e1", "start", "", map[string]interface{}{"target": nil})},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := validateWorkflowGraph(tc.nodes, tc.edges)
+			if tc.wantErr == "" {
+				if err != nil {
+					t.Fatalf("unexpected error: %s", err)
+				}
+				return
+			}
+			if err == nil || !strings.Contains(err.Error(), tc.wantErr) {
+				t.Fatalf("expected error containing %q, got %v", tc.wantErr, err)
+			}
+		})
+	}
+}
+
+func TestRawConfigInterfaceUnknownValues(t *testing.T) {
+	node := cty.ObjectVal(map[string]cty.Value{
+		"id":           cty.StringVal("sales"),
+		"type":         cty.StringVal("standalone_agent"),
+		"agent_id":     cty.UnknownVal(cty.String),
+		"phone_number": cty.NullVal(cty.String),
+		"tool_ids":     cty.NullVal(cty.List(cty.String)),
+	})
+
+	got := rawConfigInterface(node).(map[string]interface{})
+	if got["agent_id"] != nil {
+		t.Errorf("agent_id: expected nil for an unknown value, got %#v", got["agent_id"])
+	}
+	if got["phone_number"] != "" {
+		t.Errorf("phone_number: expected an empty string for a null value, got %#v", got["phone_number"])
+	}
+	if toolIDs, ok := got["tool_ids"].([]interface{}); !ok || len(toolIDs) != 0 {
+		t.Errorf("tool_ids: expected an empty list for a null value, got %#v", got["tool_ids"])
+	}
+
+	if err := validateWorkflowNode("sales", "standalone_agent", got); err != nil {
+		t.Errorf("unexpected error for an unknown agent_id: %s", err)
+	}
+}
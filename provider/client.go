@@ -6,15 +6,27 @@ import (
 	"encoding/json"
 	"fmt"
 	"io/ioutil"
+	"mime/multipart"
 	"net/http"
+	"net/url"
+	"sync"
 	"time"
 )
 
-const apiBaseURL = "https://api.elevenlabs.io/v1/convai"
+const (
+	apiHost    = "https://api.elevenlabs.io"
+	apiRootURL = apiHost + "/v1"
+	apiBaseURL = apiRootURL + "/convai"
+)
 
 type Client struct {
 	apiKey     string
 	httpClient *http.Client
+
+	// llmCatalog caches the LLM catalog used to validate plans, so it is
+	// fetched at most once per provider run.
+	llmCatalogMu sync.Mutex
+	llmCatalog   []*LLMInfo
 }
 
 func NewClient(apiKey string) *Client {
@@ -44,6 +56,30 @@ func (c *Client) newRequest(ctx context.Context, method, url string, body interf
 	return req, nil
 }
 
+func (c *Client) newMultipartRequest(ctx context.Context, method, url, fieldName, fileName string, content []byte) (*http.Request, error) {
+	var buf bytes.Buffer
+	writer := multipart.NewWriter(&buf)
+	part, err := writer.CreateFormFile(fieldName, fileName)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := part.Write(content); err != nil {
+		return nil, err
+	}
+	if err := writer.Close(); err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, method, url, &buf)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Set("Content-Type", writer.FormDataContentType())
+	req.Header.Set("xi-api-key", c.apiKey)
+	return req, nil
+}
+
 func (c *Client) do(req *http.Request, v interface{}) (*http.Response, error) {
 	resp, err := c.httpClient.Do(req)
 	if err != nil {
@@ -60,6 +96,16 @@ func (c *Client) do(req *http.Request, v interface{}) (*http.Response, error) {
 		return resp, fmt.Errorf("API error: %s, status code: %d, body: %s", resp.Status, resp.StatusCode, string(bodyBytes))
 	}
 
+	// A *[]byte receives the raw body, for endpoints that do not return JSON.
+	if raw, ok := v.(*[]byte); ok {
+		body, err := ioutil.ReadAll(resp.Body)
+		if err != nil {
+			return nil, err
+		}
+		*raw = body
+		return resp, nil
+	}
+
 	if v != nil {
 		if err := json.NewDecoder(resp.Body).Decode(v); err != nil {
 			return nil, err
@@ -74,6 +120,32 @@ type TTSConfig struct {
 	Stability       *float64 `json:"stability,omitempty"`
 	Speed           *float64 `json:"speed,omitempty"`
 	SimilarityBoost *float64 `json:"similarity_boost,omitempty"`
+
+	ModelID                  string            `json:"model_id,omitempty"`
+	AgentOutputAudioFormat   string            `json:"agent_output_audio_format,omitempty"`
+	OptimizeStreamingLatency *int              `json:"optimize_streaming_latency,omitempty"`
+	SupportedVoices          []*SupportedVoice `json:"supported_voices,omitempty"`
+
+	PronunciationDictionaryLocators []*PronunciationDictionaryLocator `json:"pronunciation_dictionary_locators,omitempty"`
+}
+
+// SupportedVoice is an additional voice the agent can switch to, selected by
+// its label.
+type SupportedVoice struct {
+	Label                    string   `json:"label"`
+	VoiceID                  string   `json:"voice_id"`
+	Description              string   `json:"description,omitempty"`
+	Language                 string   `json:"language,omitempty"`
+	ModelFamily              string   `json:"model_family,omitempty"`
+	OptimizeStreamingLatency *int     `json:"optimize_streaming_latency,omitempty"`
+	Stability                *float64 `json:"stability,omitempty"`
+	Speed                    *float64 `json:"speed,omitempty"`
+	SimilarityBoost          *float64 `json:"similarity_boost,omitempty"`
+}
+
+type PronunciationDictionaryLocator struct {
+	PronunciationDictionaryID string `json:"pronunciation_dictionary_id"`
+	VersionID                 string `json:"version_id,omitempty"`
 }
 
 type Agent struct {
@@ -81,11 +153,99 @@ type Agent struct {
 	Name               string              `json:"name,omitempty"`
 	ConversationConfig *ConversationConfig `json:"conversation_config,omitempty"`
 	Tags               []string            `json:"tags,omitempty"`
+	Workflow           *Workflow           `json:"workflow,omitempty"`
+	PlatformSettings   *PlatformSettings   `json:"platform_settings,omitempty"`
+	VersionID          string              `json:"version_id,omitempty"`
+	BranchID           string              `json:"branch_id,omitempty"`
+
+	// Read-only fields returned when listing agents.
+	CreatedAtUnixSecs int64            `json:"created_at_unix_secs,omitempty"`
+	AccessInfo        *AgentAccessInfo `json:"access_info,omitempty"`
+}
+
+type AgentAccessInfo struct {
+	IsCreator    bool   `json:"is_creator"`
+	CreatorName  string `json:"creator_name"`
+	CreatorEmail string `json:"creator_email"`
+	Role         string `json:"role"`
+}
+
+type PlatformSettings struct {
+	Testing *TestingSettings `json:"testing,omitempty"`
+	Widget  *WidgetConfig    `json:"widget,omitempty"`
+}
+
+type TestingSettings struct {
+	AttachedTests []*AttachedTest `json:"attached_tests"`
+}
+
+type AttachedTest struct {
+	TestID string `json:"test_id"`
+}
+
+// Workflow describes an agent built as a graph of nodes joined by edges,
+// both keyed by their IDs.
+type Workflow struct {
+	Nodes map[string]*WorkflowNode `json:"nodes"`
+	Edges map[string]*WorkflowEdge `json:"edges"`
+}
+
+type WorkflowNode struct {
+	Type      string                `json:"type"`
+	Label     string                `json:"label,omitempty"`
+	Position  *WorkflowNodePosition `json:"position,omitempty"`
+	EdgeOrder []string              `json:"edge_order,omitempty"`
+
+	AdditionalPrompt    string                    `json:"additional_prompt,omitempty"`
+	AdditionalToolIDs   []string                  `json:"additional_tool_ids,omitempty"`
+	Tools               []*WorkflowToolLocator    `json:"tools,omitempty"`
+	AgentID             string                    `json:"agent_id,omitempty"`
+	TransferMessage     string                    `json:"transfer_message,omitempty"`
+	TransferDestination *PhoneTransferDestination `json:"transfer_destination,omitempty"`
+}
+
+type WorkflowNodePosition struct {
+	X float64 `json:"x"`
+	Y float64 `json:"y"`
+}
+
+type WorkflowToolLocator struct {
+	ToolID string `json:"tool_id"`
+}
+
+type PhoneTransferDestination struct {
+	Type        string `json:"type"`
+	PhoneNumber string `json:"phone_number"`
+}
+
+type WorkflowEdge struct {
+	Source           string             `json:"source"`
+	Target           string             `json:"target"`
+	ForwardCondition *WorkflowCondition `json:"forward_condition,omitempty"`
+}
+
+type WorkflowCondition struct {
+	Type       string `json:"type"`
+	Label      string `json:"label,omitempty"`
+	Condition  string `json:"condition,omitempty"`
+	Successful *bool  `json:"successful,omitempty"`
 }
 
 type ConversationConfig struct {
-	Agent *AgentConfig `json:"agent,omitempty"`
-	TTS   *TTSConfig   `json:"tts,omitempty"`
+	Agent           *AgentConfig               `json:"agent,omitempty"`
+	TTS             *TTSConfig                 `json:"tts,omitempty"`
+	LanguagePresets map[string]*LanguagePreset `json:"language_presets,omitempty"`
+	Conversation    *ConversationSettings      `json:"conversation,omitempty"`
+}
+
+type ConversationSettings struct {
+	TextOnly *bool `json:"text_only,omitempty"`
+}
+
+// LanguagePreset overrides parts of the conversation config when a
+// conversation runs in the preset's language.
+type LanguagePreset struct {
+	Overrides *ConversationConfig `json:"overrides"`
 }
 
 type AgentConfig struct {
@@ -108,6 +268,33 @@ type PromptConfig struct {
 	Temperature   *float64                `json:"temperature,omitempty"`
 	MaxTokens     *int                    `json:"max_tokens,omitempty"`
 	KnowledgeBase []*KnowledgeBaseLocator `json:"knowledge_base,omitempty"`
+	CustomLLM     *CustomLLM              `json:"custom_llm,omitempty"`
+
+	BackupLLMConfig          *BackupLLMConfig `json:"backup_llm_config,omitempty"`
+	CascadeTimeoutSeconds    *float64         `json:"cascade_timeout_seconds,omitempty"`
+	ReasoningEffort          string           `json:"reasoning_effort,omitempty"`
+	IgnoreDefaultPersonality *bool            `json:"ignore_default_personality,omitempty"`
+}
+
+// BackupLLMConfig controls which models are tried when the primary LLM fails
+// or times out.
+type BackupLLMConfig struct {
+	Preference string   `json:"preference"`
+	Order      []string `json:"order,omitempty"`
+}
+
+// CustomLLM points an agent at a self-hosted, OpenAI-compatible model.
+type CustomLLM struct {
+	URL            string            `json:"url"`
+	ModelID        string            `json:"model_id,omitempty"`
+	APIKey         *SecretReference  `json:"api_key,omitempty"`
+	RequestHeaders map[string]string `json:"request_headers,omitempty"`
+	APIVersion     string            `json:"api_version,omitempty"`
+}
+
+// SecretReference refers to a secret stored in the workspace.
+type SecretReference struct {
+	SecretID string `json:"secret_id"`
 }
 
 func (c *Client) CreateAgent(ctx context.Context, agent *Agent) (*Agent, error) {
@@ -121,7 +308,17 @@ func (c *Client) CreateAgent(ctx context.Context, agent *Agent) (*Agent, error)
 }
 
 func (c *Client) GetAgent(ctx context.Context, agentID string) (*Agent, error) {
-	req, err := c.newRequest(ctx, "GET", fmt.Sprintf("%s/agents/%s", apiBaseURL, agentID), nil)
+	return c.GetAgentOnBranch(ctx, agentID, "")
+}
+
+func (c *Client) UpdateAgent(ctx context.Context, agentID string, agent *Agent) error {
+	return c.UpdateAgentOnBranch(ctx, agentID, "", agent)
+}
+
+// GetAgentOnBranch returns the agent configuration as seen on the given
+// branch. An empty branchID returns the main branch.
+func (c *Client) GetAgentOnBranch(ctx context.Context, agentID, branchID string) (*Agent, error) {
+	req, err := c.newRequest(ctx, "GET", agentURL(agentID, branchID), nil)
 	if err != nil {
 		return nil, err
 	}
@@ -137,8 +334,10 @@ func (c *Client) GetAgent(ctx context.Context, agentID string) (*Agent, error) {
 	return &agent, nil
 }
 
-func (c *Client) UpdateAgent(ctx context.Context, agentID string, agent *Agent) error {
-	req, err := c.newRequest(ctx, "PATCH", fmt.Sprintf("%s/agents/%s", apiBaseURL, agentID), agent)
+// UpdateAgentOnBranch applies the same PATCH as UpdateAgent to a branch of
+// the agent. An empty branchID updates the main branch.
+func (c *Client) UpdateAgentOnBranch(ctx context.Context, agentID, branchID string, agent *Agent) error {
+	req, err := c.newRequest(ctx, "PATCH", agentURL(agentID, branchID), agent)
 	if err != nil {
 		return err
 	}
@@ -146,6 +345,49 @@ func (c *Client) UpdateAgent(ctx context.Context, agentID string, agent *Agent)
 	return err
 }
 
+func agentURL(agentID, branchID string) string {
+	u := fmt.Sprintf("%s/agents/%s", apiBaseURL, agentID)
+	if branchID != "" {
+		u += "?branch_id=" + url.QueryEscape(branchID)
+	}
+	return u
+}
+
+// ListAgents returns every agent matching search, following the pagination
+// cursor until the last page. The listed agents only carry their ID, name,
+// tags, creation time and access info.
+func (c *Client) ListAgents(ctx context.Context, search string) ([]*Agent, error) {
+	var agents []*Agent
+	cursor := ""
+	for {
+		query := url.Values{}
+		query.Set("page_size", "100")
+		if search != "" {
+			query.Set("search", search)
+		}
+		if cursor != "" {
+			query.Set("cursor", cursor)
+		}
+		req, err := c.newRequest(ctx, "GET", fmt.Sprintf("%s/agents?%s", apiBaseURL, query.Encode()), nil)
+		if err != nil {
+			return nil, err
+		}
+		var page struct {
+			Agents     []*Agent `json:"agents"`
+			NextCursor string   `json:"next_cursor"`
+			HasMore    bool     `json:"has_more"`
+		}
+		if _, err := c.do(req, &page); err != nil {
+			return nil, err
+		}
+		agents = append(agents, page.Agents...)
+		if !page.HasMore || page.NextCursor == "" {
+			return agents, nil
+		}
+		cursor = page.NextCursor
+	}
+}
+
 func (c *Client) DeleteAgent(ctx context.Context, agentID string) error {
 	req, err := c.newRequest(ctx, "DELETE", fmt.Sprintf("%s/agents/%s", apiBaseURL, agentID), nil)
 	if err != nil {
@@ -155,75 +397,132 @@ func (c *Client) DeleteAgent(ctx context.Context, agentID string) error {
 	return err
 }
 
-// Tool
-type LiteralJsonSchemaProperty struct {
-	Type        string `json:"type"`
-	Description string `json:"description,omitempty"`
+// Widget
+type WidgetConfig struct {
+	Variant                 string             `json:"variant,omitempty"`
+	Expandable              string             `json:"expandable,omitempty"`
+	Avatar                  *WidgetAvatar      `json:"avatar,omitempty"`
+	FeedbackMode            string             `json:"feedback_mode,omitempty"`
+	BgColor                 string             `json:"bg_color,omitempty"`
+	TextColor               string             `json:"text_color,omitempty"`
+	BtnColor                string             `json:"btn_color,omitempty"`
+	BtnTextColor            string             `json:"btn_text_color,omitempty"`
+	BorderColor             string             `json:"border_color,omitempty"`
+	FocusColor              string             `json:"focus_color,omitempty"`
+	BorderRadius            *int               `json:"border_radius,omitempty"`
+	BtnRadius               *int               `json:"btn_radius,omitempty"`
+	ActionText              string             `json:"action_text,omitempty"`
+	StartCallText           string             `json:"start_call_text,omitempty"`
+	EndCallText             string             `json:"end_call_text,omitempty"`
+	ExpandText              string             `json:"expand_text,omitempty"`
+	ListeningText           string             `json:"listening_text,omitempty"`
+	SpeakingText            string             `json:"speaking_text,omitempty"`
+	TermsText               *string            `json:"terms_text,omitempty"`
+	TermsHTML               *string            `json:"terms_html,omitempty"`
+	TermsKey                *string            `json:"terms_key,omitempty"`
+	ShowAvatarWhenCollapsed *bool              `json:"show_avatar_when_collapsed,omitempty"`
+	DisableBanner           *bool              `json:"disable_banner,omitempty"`
+	TranscriptEnabled       *bool              `json:"transcript_enabled,omitempty"`
+	TextInputEnabled        *bool              `json:"text_input_enabled,omitempty"`
+	TextContents            *map[string]string `json:"text_contents,omitempty"`
 }
 
-type QueryParamsJsonSchema struct {
-	Properties map[string]LiteralJsonSchemaProperty `json:"properties"`
-	Required   []string                             `json:"required,omitempty"`
+type WidgetAvatar struct {
+	Type      string `json:"type"`
+	Color1    string `json:"color_1,omitempty"`
+	Color2    string `json:"color_2,omitempty"`
+	CustomURL string `json:"custom_url,omitempty"`
+	URL       string `json:"url,omitempty"`
 }
 
-type APISchema struct {
-	URL               string                               `json:"url"`
-	Method            string                               `json:"method,omitempty"`
-	PathParamsSchema  map[string]LiteralJsonSchemaProperty `json:"path_params_schema,omitempty"`
-	QueryParamsSchema *QueryParamsJsonSchema               `json:"query_params_schema,omitempty"`
-	RequestBodySchema json.RawMessage                      `json:"request_body_schema,omitempty"`
-	RequestHeaders    map[string]string                    `json:"request_headers,omitempty"`
+// UploadAgentAvatar sets the widget avatar of the agent to the given image
+// and returns the URL it is served from.
+func (c *Client) UploadAgentAvatar(ctx context.Context, agentID, fileName string, image []byte) (string, error) {
+	req, err := c.newMultipartRequest(ctx, "POST", fmt.Sprintf("%s/agents/%s/avatar", apiBaseURL, agentID), "avatar_file", fileName, image)
+	if err != nil {
+		return "", err
+	}
+	var avatar struct {
+		AvatarURL string `json:"avatar_url"`
+	}
+	_, err = c.do(req, &avatar)
+	return avatar.AvatarURL, err
 }
 
-type Tool struct {
-	Name                 string     `json:"name"`
-	Description          string     `json:"description,omitempty"`
-	APISchema            *APISchema `json:"api_schema,omitempty"`
-	Type                 string     `json:"type,omitempty"`
-	ResponseTimeoutSecs  int        `json:"response_timeout_secs,omitempty"`
-	DisableInterruptions bool       `json:"disable_interruptions,omitempty"`
-	ForcePreToolSpeech   bool       `json:"force_pre_tool_speech,omitempty"`
+// Agent Branch
+type AgentBranch struct {
+	ID                    string  `json:"id"`
+	AgentID               string  `json:"agent_id,omitempty"`
+	Name                  string  `json:"name"`
+	Description           string  `json:"description,omitempty"`
+	IsArchived            bool    `json:"is_archived"`
+	CurrentLivePercentage float64 `json:"current_live_percentage"`
 }
 
-type ToolRequest struct {
-	ToolConfig Tool `json:"tool_config"`
+type AgentBranchRequest struct {
+	ParentVersionID    string              `json:"parent_version_id"`
+	Name               string              `json:"name"`
+	Description        string              `json:"description,omitempty"`
+	ConversationConfig *ConversationConfig `json:"conversation_config,omitempty"`
 }
 
-type ToolResponse struct {
-	ID         string `json:"id"`
-	ToolConfig Tool   `json:"tool_config"`
+type AgentBranchResponse struct {
+	CreatedBranchID  string `json:"created_branch_id"`
+	CreatedVersionID string `json:"created_version_id"`
 }
 
-func (c *Client) CreateTool(ctx context.Context, tool *Tool) (*ToolResponse, error) {
-	toolRequest := ToolRequest{ToolConfig: *tool}
-	req, err := c.newRequest(ctx, "POST", fmt.Sprintf("%s/tools", apiBaseURL), &toolRequest)
+func (c *Client) CreateAgentBranch(ctx context.Context, agentID string, branch *AgentBranchRequest) (*AgentBranchResponse, error) {
+	req, err := c.newRequest(ctx, "POST", fmt.Sprintf("%s/agents/%s/branches", apiBaseURL, agentID), branch)
 	if err != nil {
 		return nil, err
 	}
-	var createdTool ToolResponse
-	_, err = c.do(req, &createdTool)
-	return &createdTool, err
+	var createdBranch AgentBranchResponse
+	_, err = c.do(req, &createdBranch)
+	return &createdBranch, err
 }
 
-func (c *Client) GetTool(ctx context.Context, toolID string) (*ToolResponse, error) {
-	req, err := c.newRequest(ctx, "GET", fmt.Sprintf("%s/tools/%s", apiBaseURL, toolID), nil)
+func (c *Client) GetAgentBranch(ctx context.Context, agentID, branchID string) (*AgentBranch, error) {
+	req, err := c.newRequest(ctx, "GET", fmt.Sprintf("%s/agents/%s/branches/%s", apiBaseURL, agentID, branchID), nil)
 	if err != nil {
 		return nil, err
 	}
-	var tool ToolResponse
-	resp, err := c.do(req, &tool)
+	var branch AgentBranch
+	resp, err := c.do(req, &branch)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode == http.StatusNotFound || branch.IsArchived {
+		return nil, nil
+	}
+	return &branch, nil
+}
+
+// ListAgentBranches returns the branches of an agent, or nil when the agent
+// does not exist. An agent without branches returns an empty, non-nil list.
+func (c *Client) ListAgentBranches(ctx context.Context, agentID string) ([]*AgentBranch, error) {
+	req, err := c.newRequest(ctx, "GET", fmt.Sprintf("%s/agents/%s/branches", apiBaseURL, agentID), nil)
+	if err != nil {
+		return nil, err
+	}
+	var branches struct {
+		Results []*AgentBranch `json:"results"`
+	}
+	resp, err := c.do(req, &branches)
 	if err != nil {
 		return nil, err
 	}
 	if resp.StatusCode == http.StatusNotFound {
 		return nil, nil
 	}
-	return &tool, nil
+	if branches.Results == nil {
+		return []*AgentBranch{}, nil
+	}
+	return branches.Results, nil
 }
 
-func (c *Client) UpdateTool(ctx context.Context, toolID string, tool *Tool) error {
-	toolRequest := ToolRequest{ToolConfig: *tool}
-	req, err := c.newRequest(ctx, "PATCH", fmt.Sprintf("%s/tools/%s", apiBaseURL, toolID), &toolRequest)
+func (c *Client) UpdateAgentBranch(ctx context.Context, agentID, branchID string, name, description string) error {
+	body := map[string]interface{}{"name": name, "description": description}
+	req, err := c.newRequest(ctx, "PATCH", fmt.Sprintf("%s/agents/%s/branches/%s", apiBaseURL, agentID, branchID), body)
 	if err != nil {
 		return err
 	}
@@ -231,8 +530,1223 @@ func (c *Client) UpdateTool(ctx context.Context, toolID string, tool *Tool) erro
 	return err
 }
 
-func (c *Client) DeleteTool(ctx context.Context, toolID string) error {
-	req, err := c.newRequest(ctx, "DELETE", fmt.Sprintf("%s/tools/%s", apiBaseURL, toolID), nil)
+// ArchiveAgentBranch archives a branch; branches cannot be deleted.
+func (c *Client) ArchiveAgentBranch(ctx context.Context, agentID, branchID string) error {
+	body := map[string]interface{}{"is_archived": true}
+	req, err := c.newRequest(ctx, "PATCH", fmt.Sprintf("%s/agents/%s/branches/%s", apiBaseURL, agentID, branchID), body)
+	if err != nil {
+		return err
+	}
+	_, err = c.do(req, nil)
+	return err
+}
+
+// Agent Deployment
+type DeploymentStrategy struct {
+	Type              string  `json:"type"`
+	TrafficPercentage float64 `json:"traffic_percentage"`
+}
+
+type DeploymentRequest struct {
+	BranchID           string              `json:"branch_id"`
+	DeploymentStrategy *DeploymentStrategy `json:"deployment_strategy"`
+}
+
+// DeployAgent splits the agent's live traffic across branches by percentage.
+func (c *Client) DeployAgent(ctx context.Context, agentID string, requests []*DeploymentRequest) error {
+	body := map[string]interface{}{
+		"deployment_request": map[string]interface{}{"requests": requests},
+	}
+	req, err := c.newRequest(ctx, "POST", fmt.Sprintf("%s/agents/%s/deployments", apiBaseURL, agentID), body)
+	if err != nil {
+		return err
+	}
+	_, err = c.do(req, nil)
+	return err
+}
+
+// Agent Test
+type AgentTestChatMessage struct {
+	Role    string `json:"role"`
+	Message string `json:"message"`
+}
+
+type AgentTestExample struct {
+	Response string `json:"response"`
+	Type     string `json:"type"`
+}
+
+type AgentTestReferencedTool struct {
+	ID   string `json:"id"`
+	Type string `json:"type"`
+}
+
+type AgentTestParameterEvaluation struct {
+	Type          string `json:"type"`
+	ExpectedValue string `json:"expected_value,omitempty"`
+	Pattern       string `json:"pattern,omitempty"`
+	Description   string `json:"description,omitempty"`
+}
+
+type AgentTestToolCallParameter struct {
+	Path string                        `json:"path"`
+	Eval *AgentTestParameterEvaluation `json:"eval"`
+}
+
+type AgentTestToolCallParameters struct {
+	ReferencedTool *AgentTestReferencedTool      `json:"referenced_tool"`
+	Parameters     []*AgentTestToolCallParameter `json:"parameters"`
+	VerifyAbsence  bool                          `json:"verify_absence"`
+}
+
+type AgentTest struct {
+	ID                 string                       `json:"id,omitempty"`
+	Name               string                       `json:"name"`
+	Type               string                       `json:"type,omitempty"`
+	ChatHistory        []*AgentTestChatMessage      `json:"chat_history"`
+	SuccessCondition   string                       `json:"success_condition"`
+	SuccessExamples    []*AgentTestExample          `json:"success_examples"`
+	FailureExamples    []*AgentTestExample          `json:"failure_examples"`
+	ToolCallParameters *AgentTestToolCallParameters `json:"tool_call_parameters,omitempty"`
+	DynamicVariables   map[string]string            `json:"dynamic_variables,omitempty"`
+}
+
+type AgentTestRationale struct {
+	Summary string `json:"summary"`
+}
+
+type AgentTestConditionResult struct {
+	Result    string              `json:"result"`
+	Rationale *AgentTestRationale `json:"rationale,omitempty"`
+}
+
+type AgentTestRun struct {
+	TestRunID       string                    `json:"test_run_id"`
+	TestID          string                    `json:"test_id"`
+	TestName        string                    `json:"test_name,omitempty"`
+	Status          string                    `json:"status"`
+	ConditionResult *AgentTestConditionResult `json:"condition_result,omitempty"`
+}
+
+type AgentTestInvocation struct {
+	ID       string          `json:"id"`
+	TestRuns []*AgentTestRun `json:"test_runs"`
+}
+
+func (c *Client) CreateAgentTest(ctx context.Context, test *AgentTest) (*AgentTest, error) {
+	req, err := c.newRequest(ctx, "POST", fmt.Sprintf("%s/agent-testing/create", apiBaseURL), test)
+	if err != nil {
+		return nil, err
+	}
+	var createdTest AgentTest
+	_, err = c.do(req, &createdTest)
+	return &createdTest, err
+}
+
+func (c *Client) GetAgentTest(ctx context.Context, testID string) (*AgentTest, error) {
+	req, err := c.newRequest(ctx, "GET", fmt.Sprintf("%s/agent-testing/%s", apiBaseURL, testID), nil)
+	if err != nil {
+		return nil, err
+	}
+	var test AgentTest
+	resp, err := c.do(req, &test)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode == http.StatusNotFound {
+		return nil, nil
+	}
+	return &test, nil
+}
+
+func (c *Client) UpdateAgentTest(ctx context.Context, testID string, test *AgentTest) error {
+	req, err := c.newRequest(ctx, "PUT", fmt.Sprintf("%s/agent-testing/%s", apiBaseURL, testID), test)
+	if err != nil {
+		return err
+	}
+	_, err = c.do(req, nil)
+	return err
+}
+
+func (c *Client) DeleteAgentTest(ctx context.Context, testID string) error {
+	req, err := c.newRequest(ctx, "DELETE", fmt.Sprintf("%s/agent-testing/%s", apiBaseURL, testID), nil)
+	if err != nil {
+		return err
+	}
+	_, err = c.do(req, nil)
+	return err
+}
+
+// RunAgentTests starts the given tests against the agent's current
+// configuration. Poll GetAgentTestInvocation for the results.
+func (c *Client) RunAgentTests(ctx context.Context, agentID string, testIDs []string) (*AgentTestInvocation, error) {
+	tests := make([]*AttachedTest, len(testIDs))
+	for i, testID := range testIDs {
+		tests[i] = &AttachedTest{TestID: testID}
+	}
+	body := map[string]interface{}{"tests": tests}
+	req, err := c.newRequest(ctx, "POST", fmt.Sprintf("%s/agents/%s/run-tests", apiBaseURL, agentID), body)
+	if err != nil {
+		return nil, err
+	}
+	var invocation AgentTestInvocation
+	_, err = c.do(req, &invocation)
+	return &invocation, err
+}
+
+func (c *Client) GetAgentTestInvocation(ctx context.Context, invocationID string) (*AgentTestInvocation, error) {
+	req, err := c.newRequest(ctx, "GET", fmt.Sprintf("%s/test-invocations/%s", apiBaseURL, invocationID), nil)
+	if err != nil {
+		return nil, err
+	}
+	var invocation AgentTestInvocation
+	resp, err := c.do(req, &invocation)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode == http.StatusNotFound {
+		return nil, fmt.Errorf("test invocation %s not found", invocationID)
+	}
+	return &invocation, nil
+}
+
+// Batch Call
+type ConversationInitiationClientData struct {
+	DynamicVariables map[string]string `json:"dynamic_variables,omitempty"`
+}
+
+type BatchCallRecipient struct {
+	PhoneNumber                      string                            `json:"phone_number"`
+	ConversationInitiationClientData *ConversationInitiationClientData `json:"conversation_initiation_client_data,omitempty"`
+}
+
+type BatchCallRequest struct {
+	CallName           string                `json:"call_name"`
+	AgentID            string                `json:"agent_id"`
+	AgentPhoneNumberID string                `json:"agent_phone_number_id"`
+	ScheduledTimeUnix  *int64                `json:"scheduled_time_unix,omitempty"`
+	Recipients         []*BatchCallRecipient `json:"recipients"`
+}
+
+type BatchCall struct {
+	ID                   string `json:"id"`
+	PhoneNumberID        string `json:"phone_number_id"`
+	Name                 string `json:"name"`
+	AgentID              string `json:"agent_id"`
+	CreatedAtUnix        int64  `json:"created_at_unix"`
+	ScheduledTimeUnix    int64  `json:"scheduled_time_unix"`
+	TotalCallsDispatched int    `json:"total_calls_dispatched"`
+	TotalCallsScheduled  int    `json:"total_calls_scheduled"`
+	LastUpdatedAtUnix    int64  `json:"last_updated_at_unix"`
+	Status               string `json:"status"`
+}
+
+func (c *Client) CreateBatchCall(ctx context.Context, batch *BatchCallRequest) (*BatchCall, error) {
+	req, err := c.newRequest(ctx, "POST", fmt.Sprintf("%s/batch-calling/submit", apiBaseURL), batch)
+	if err != nil {
+		return nil, err
+	}
+	var createdBatch BatchCall
+	_, err = c.do(req, &createdBatch)
+	return &createdBatch, err
+}
+
+func (c *Client) GetBatchCall(ctx context.Context, batchID string) (*BatchCall, error) {
+	req, err := c.newRequest(ctx, "GET", fmt.Sprintf("%s/batch-calling/%s", apiBaseURL, batchID), nil)
+	if err != nil {
+		return nil, err
+	}
+	var batch BatchCall
+	resp, err := c.do(req, &batch)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode == http.StatusNotFound {
+		return nil, nil
+	}
+	return &batch, nil
+}
+
+func (c *Client) CancelBatchCall(ctx context.Context, batchID string) error {
+	req, err := c.newRequest(ctx, "POST", fmt.Sprintf("%s/batch-calling/%s/cancel", apiBaseURL, batchID), nil)
+	if err != nil {
+		return err
+	}
+	_, err = c.do(req, nil)
+	return err
+}
+
+// Workspace Resource Sharing
+type WorkspaceResourceShareOption struct {
+	Name string `json:"name"`
+	ID   string `json:"id"`
+	Type string `json:"type"`
+}
+
+type WorkspaceResource struct {
+	ResourceID     string                          `json:"resource_id"`
+	ResourceType   string                          `json:"resource_type"`
+	CreatorUserID  string                          `json:"creator_user_id"`
+	RoleToGroupIDs map[string][]string             `json:"role_to_group_ids"`
+	ShareOptions   []*WorkspaceResourceShareOption `json:"share_options"`
+}
+
+type WorkspaceResourceShareRequest struct {
+	Role         string `json:"role,omitempty"`
+	ResourceType string `json:"resource_type"`
+	UserEmail    string `json:"user_email,omitempty"`
+	GroupID      string `json:"group_id,omitempty"`
+}
+
+func (c *Client) GetWorkspaceResource(ctx context.Context, resourceType, resourceID string) (*WorkspaceResource, error) {
+	req, err := c.newRequest(ctx, "GET", fmt.Sprintf("%s/workspace/resources/%s?resource_type=%s", apiRootURL, resourceID, url.QueryEscape(resourceType)), nil)
+	if err != nil {
+		return nil, err
+	}
+	var resource WorkspaceResource
+	resp, err := c.do(req, &resource)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode == http.StatusNotFound {
+		return nil, nil
+	}
+	return &resource, nil
+}
+
+// ShareWorkspaceResource grants the user or group the given role on the
+// resource, replacing any role they already had.
+func (c *Client) ShareWorkspaceResource(ctx context.Context, resourceID string, share *WorkspaceResourceShareRequest) error {
+	req, err := c.newRequest(ctx, "POST", fmt.Sprintf("%s/workspace/resources/%s/share", apiRootURL, resourceID), share)
+	if err != nil {
+		return err
+	}
+	_, err = c.do(req, nil)
+	return err
+}
+
+func (c *Client) UnshareWorkspaceResource(ctx context.Context, resourceID string, share *WorkspaceResourceShareRequest) error {
+	req, err := c.newRequest(ctx, "POST", fmt.Sprintf("%s/workspace/resources/%s/unshare", apiRootURL, resourceID), share)
+	if err != nil {
+		return err
+	}
+	_, err = c.do(req, nil)
+	return err
+}
+
+// Service Account
+type ServiceAccount struct {
+	ServiceAccountUserID string `json:"service_account_user_id"`
+	Name                 string `json:"name"`
+	CreatedAtUnix        int64  `json:"created_at_unix,omitempty"`
+}
+
+// APIKeyPermissions is either the single permission "all" or a list of
+// scopes; the API encodes the former as a bare string.
+type APIKeyPermissions []string
+
+func (p APIKeyPermissions) MarshalJSON() ([]byte, error) {
+	if len(p) == 1 && p[0] == "all" {
+		return json.Marshal("all")
+	}
+	return json.Marshal([]string(p))
+}
+
+func (p *APIKeyPermissions) UnmarshalJSON(data []byte) error {
+	var all string
+	if err := json.Unmarshal(data, &all); err == nil {
+		*p = APIKeyPermissions{all}
+		return nil
+	}
+	var scopes []string
+	if err := json.Unmarshal(data, &scopes); err != nil {
+		return err
+	}
+	*p = scopes
+	return nil
+}
+
+type ServiceAccountAPIKey struct {
+	KeyID          string            `json:"key_id,omitempty"`
+	Name           string            `json:"name"`
+	Hint           string            `json:"hint,omitempty"`
+	IsDisabled     bool              `json:"is_disabled,omitempty"`
+	IsEnabled      *bool             `json:"is_enabled,omitempty"`
+	Permissions    APIKeyPermissions `json:"permissions"`
+	CharacterLimit *int              `json:"character_limit"`
+	CharacterCount int               `json:"character_count,omitempty"`
+}
+
+type ServiceAccountAPIKeyResponse struct {
+	KeyID  string `json:"key_id"`
+	APIKey string `json:"xi-api-key"`
+}
+
+func (c *Client) CreateServiceAccount(ctx context.Context, name string) (*ServiceAccount, error) {
+	body := map[string]interface{}{"name": name}
+	req, err := c.newRequest(ctx, "POST", fmt.Sprintf("%s/service-accounts", apiRootURL), body)
+	if err != nil {
+		return nil, err
+	}
+	var account ServiceAccount
+	_, err = c.do(req, &account)
+	return &account, err
+}
+
+func (c *Client) GetServiceAccount(ctx context.Context, serviceAccountID string) (*ServiceAccount, error) {
+	req, err := c.newRequest(ctx, "GET", fmt.Sprintf("%s/service-accounts", apiRootURL), nil)
+	if err != nil {
+		return nil, err
+	}
+	var accounts struct {
+		ServiceAccounts []*ServiceAccount `json:"service-accounts"`
+	}
+	if _, err := c.do(req, &accounts); err != nil {
+		return nil, err
+	}
+	for _, account := range accounts.ServiceAccounts {
+		if account.ServiceAccountUserID == serviceAccountID {
+			return account, nil
+		}
+	}
+	return nil, nil
+}
+
+func (c *Client) UpdateServiceAccount(ctx context.Context, serviceAccountID, name string) error {
+	body := map[string]interface{}{"name": name}
+	req, err := c.newRequest(ctx, "PATCH", fmt.Sprintf("%s/service-accounts/%s", apiRootURL, serviceAccountID), body)
+	if err != nil {
+		return err
+	}
+	_, err = c.do(req, nil)
+	return err
+}
+
+func (c *Client) DeleteServiceAccount(ctx context.Context, serviceAccountID string) error {
+	req, err := c.newRequest(ctx, "DELETE", fmt.Sprintf("%s/service-accounts/%s", apiRootURL, serviceAccountID), nil)
+	if err != nil {
+		return err
+	}
+	_, err = c.do(req, nil)
+	return err
+}
+
+// CreateServiceAccountAPIKey creates an API key. The key value is only ever
+// returned by this call.
+func (c *Client) CreateServiceAccountAPIKey(ctx context.Context, serviceAccountID string, key *ServiceAccountAPIKey) (*ServiceAccountAPIKeyResponse, error) {
+	req, err := c.newRequest(ctx, "POST", fmt.Sprintf("%s/service-accounts/%s/api-keys", apiRootURL, serviceAccountID), key)
+	if err != nil {
+		return nil, err
+	}
+	var createdKey ServiceAccountAPIKeyResponse
+	_, err = c.do(req, &createdKey)
+	return &createdKey, err
+}
+
+func (c *Client) GetServiceAccountAPIKey(ctx context.Context, serviceAccountID, keyID string) (*ServiceAccountAPIKey, error) {
+	req, err := c.newRequest(ctx, "GET", fmt.Sprintf("%s/service-accounts/%s/api-keys", apiRootURL, serviceAccountID), nil)
+	if err != nil {
+		return nil, err
+	}
+	var keys struct {
+		APIKeys []*ServiceAccountAPIKey `json:"api-keys"`
+	}
+	resp, err := c.do(req, &keys)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode == http.StatusNotFound {
+		return nil, nil
+	}
+	for _, key := range keys.APIKeys {
+		if key.KeyID == keyID {
+			return key, nil
+		}
+	}
+	return nil, nil
+}
+
+func (c *Client) UpdateServiceAccountAPIKey(ctx context.Context, serviceAccountID, keyID string, key *ServiceAccountAPIKey) error {
+	req, err := c.newRequest(ctx, "PATCH", fmt.Sprintf("%s/service-accounts/%s/api-keys/%s", apiRootURL, serviceAccountID, keyID), key)
+	if err != nil {
+		return err
+	}
+	_, err = c.do(req, nil)
+	return err
+}
+
+func (c *Client) DeleteServiceAccountAPIKey(ctx context.Context, serviceAccountID, keyID string) error {
+	req, err := c.newRequest(ctx, "DELETE", fmt.Sprintf("%s/service-accounts/%s/api-keys/%s", apiRootURL, serviceAccountID, keyID), nil)
+	if err != nil {
+		return err
+	}
+	_, err = c.do(req, nil)
+	return err
+}
+
+// ConvAI Settings
+type ConversationInitiationWebhook struct {
+	URL            string            `json:"url,omitempty"`
+	RequestHeaders map[string]string `json:"request_headers,omitempty"`
+}
+
+type ConvAIWebhooks struct {
+	PostCallWebhookID string `json:"post_call_webhook_id,omitempty"`
+	SendAudio         *bool  `json:"send_audio,omitempty"`
+}
+
+// ConvAISettings are the conversational AI settings shared by every agent in
+// the workspace.
+type ConvAISettings struct {
+	ConversationInitiationClientDataWebhook *ConversationInitiationWebhook `json:"conversation_initiation_client_data_webhook,omitempty"`
+	Webhooks                                *ConvAIWebhooks                `json:"webhooks,omitempty"`
+	CanUseMCPServers                        *bool                          `json:"can_use_mcp_servers,omitempty"`
+	RAGRetentionPeriodDays                  *int                           `json:"rag_retention_period_days,omitempty"`
+	DefaultLivekitStack                     string                         `json:"default_livekit_stack,omitempty"`
+}
+
+func (c *Client) GetConvAISettings(ctx context.Context) (*ConvAISettings, error) {
+	req, err := c.newRequest(ctx, "GET", fmt.Sprintf("%s/settings", apiBaseURL), nil)
+	if err != nil {
+		return nil, err
+	}
+	var settings ConvAISettings
+	_, err = c.do(req, &settings)
+	return &settings, err
+}
+
+func (c *Client) UpdateConvAISettings(ctx context.Context, settings *ConvAISettings) error {
+	req, err := c.newRequest(ctx, "PATCH", fmt.Sprintf("%s/settings", apiBaseURL), settings)
+	if err != nil {
+		return err
+	}
+	_, err = c.do(req, nil)
+	return err
+}
+
+// WhatsApp Account
+type WhatsAppAccountRequest struct {
+	BusinessAccountID string `json:"business_account_id"`
+	PhoneNumberID     string `json:"phone_number_id"`
+	TokenCode         string `json:"token_code"`
+}
+
+type WhatsAppAccount struct {
+	BusinessAccountID   string `json:"business_account_id"`
+	PhoneNumberID       string `json:"phone_number_id"`
+	BusinessAccountName string `json:"business_account_name,omitempty"`
+	PhoneNumberName     string `json:"phone_number_name,omitempty"`
+	PhoneNumber         string `json:"phone_number,omitempty"`
+	AssignedAgentID     string `json:"assigned_agent_id,omitempty"`
+}
+
+func (c *Client) CreateWhatsAppAccount(ctx context.Context, account *WhatsAppAccountRequest) error {
+	req, err := c.newRequest(ctx, "POST", fmt.Sprintf("%s/whatsapp-accounts", apiBaseURL), account)
+	if err != nil {
+		return err
+	}
+	_, err = c.do(req, nil)
+	return err
+}
+
+func (c *Client) GetWhatsAppAccount(ctx context.Context, phoneNumberID string) (*WhatsAppAccount, error) {
+	req, err := c.newRequest(ctx, "GET", fmt.Sprintf("%s/whatsapp-accounts/%s", apiBaseURL, phoneNumberID), nil)
+	if err != nil {
+		return nil, err
+	}
+	var account WhatsAppAccount
+	resp, err := c.do(req, &account)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode == http.StatusNotFound {
+		return nil, nil
+	}
+	return &account, nil
+}
+
+// AssignWhatsAppAccountAgent routes the account's messages to the agent. An
+// empty agentID unassigns the current agent.
+func (c *Client) AssignWhatsAppAccountAgent(ctx context.Context, phoneNumberID, agentID string) error {
+	body := map[string]interface{}{"assigned_agent_id": nil}
+	if agentID != "" {
+		body["assigned_agent_id"] = agentID
+	}
+	req, err := c.newRequest(ctx, "PATCH", fmt.Sprintf("%s/whatsapp-accounts/%s", apiBaseURL, phoneNumberID), body)
+	if err != nil {
+		return err
+	}
+	_, err = c.do(req, nil)
+	return err
+}
+
+func (c *Client) DeleteWhatsAppAccount(ctx context.Context, phoneNumberID string) error {
+	req, err := c.newRequest(ctx, "DELETE", fmt.Sprintf("%s/whatsapp-accounts/%s", apiBaseURL, phoneNumberID), nil)
+	if err != nil {
+		return err
+	}
+	_, err = c.do(req, nil)
+	return err
+}
+
+// Outbound Call
+type OutboundCallRequest struct {
+	AgentID                          string                            `json:"agent_id"`
+	AgentPhoneNumberID               string                            `json:"agent_phone_number_id"`
+	ToNumber                         string                            `json:"to_number"`
+	ConversationInitiationClientData *ConversationInitiationClientData `json:"conversation_initiation_client_data,omitempty"`
+}
+
+type OutboundCallResponse struct {
+	Success        bool   `json:"success"`
+	Message        string `json:"message"`
+	ConversationID string `json:"conversation_id"`
+	CallSID        string `json:"callSid"`
+	SIPCallID      string `json:"sip_call_id"`
+}
+
+// CreateOutboundCall places a call from an agent phone number. telephony is
+// the provider of that number, either "twilio" or "sip_trunk".
+func (c *Client) CreateOutboundCall(ctx context.Context, telephony string, call *OutboundCallRequest) (*OutboundCallResponse, error) {
+	path := "twilio"
+	if telephony == "sip_trunk" {
+		path = "sip-trunk"
+	}
+	req, err := c.newRequest(ctx, "POST", fmt.Sprintf("%s/%s/outbound-call", apiBaseURL, path), call)
+	if err != nil {
+		return nil, err
+	}
+	var result OutboundCallResponse
+	if _, err := c.do(req, &result); err != nil {
+		return nil, err
+	}
+	if !result.Success {
+		return nil, fmt.Errorf("outbound call to %s failed: %s", call.ToNumber, result.Message)
+	}
+	return &result, nil
+}
+
+// Voice
+type Voice struct {
+	VoiceID     string            `json:"voice_id"`
+	Name        string            `json:"name"`
+	Category    string            `json:"category"`
+	Description string            `json:"description"`
+	Labels      map[string]string `json:"labels"`
+	PreviewURL  string            `json:"preview_url"`
+}
+
+// ListVoices returns every voice matching search and category, following
+// the pagination token until the last page.
+func (c *Client) ListVoices(ctx context.Context, search, category string) ([]*Voice, error) {
+	var voices []*Voice
+	pageToken := ""
+	for {
+		query := url.Values{}
+		query.Set("page_size", "100")
+		if search != "" {
+			query.Set("search", search)
+		}
+		if category != "" {
+			query.Set("category", category)
+		}
+		if pageToken != "" {
+			query.Set("next_page_token", pageToken)
+		}
+		req, err := c.newRequest(ctx, "GET", fmt.Sprintf("%s/v2/voices?%s", apiHost, query.Encode()), nil)
+		if err != nil {
+			return nil, err
+		}
+		var page struct {
+			Voices        []*Voice `json:"voices"`
+			HasMore       bool     `json:"has_more"`
+			NextPageToken string   `json:"next_page_token"`
+		}
+		if _, err := c.do(req, &page); err != nil {
+			return nil, err
+		}
+		voices = append(voices, page.Voices...)
+		if !page.HasMore || page.NextPageToken == "" {
+			return voices, nil
+		}
+		pageToken = page.NextPageToken
+	}
+}
+
+func (c *Client) GetVoice(ctx context.Context, voiceID string) (*Voice, error) {
+	req, err := c.newRequest(ctx, "GET", fmt.Sprintf("%s/voices/%s", apiRootURL, voiceID), nil)
+	if err != nil {
+		return nil, err
+	}
+	var voice Voice
+	resp, err := c.do(req, &voice)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode == http.StatusNotFound {
+		return nil, nil
+	}
+	return &voice, nil
+}
+
+// Model
+type Model struct {
+	ModelID                     string           `json:"model_id"`
+	Name                        string           `json:"name"`
+	Description                 string           `json:"description"`
+	CanDoTextToSpeech           bool             `json:"can_do_text_to_speech"`
+	CanDoVoiceConversion        bool             `json:"can_do_voice_conversion"`
+	CanUseStyle                 bool             `json:"can_use_style"`
+	CanUseSpeakerBoost          bool             `json:"can_use_speaker_boost"`
+	ServesProVoices             bool             `json:"serves_pro_voices"`
+	TokenCostFactor             float64          `json:"token_cost_factor"`
+	MaximumTextLengthPerRequest int              `json:"maximum_text_length_per_request"`
+	Languages                   []*ModelLanguage `json:"languages"`
+}
+
+type ModelLanguage struct {
+	LanguageID string `json:"language_id"`
+	Name       string `json:"name"`
+}
+
+func (c *Client) ListModels(ctx context.Context) ([]*Model, error) {
+	req, err := c.newRequest(ctx, "GET", fmt.Sprintf("%s/models", apiRootURL), nil)
+	if err != nil {
+		return nil, err
+	}
+	var models []*Model
+	_, err = c.do(req, &models)
+	return models, err
+}
+
+// LLM
+type LLMInfo struct {
+	LLM                       string              `json:"llm"`
+	MaxTokensLimit            int                 `json:"max_tokens_limit"`
+	MaxContextLimit           int                 `json:"max_context_limit"`
+	SupportsImageInput        bool                `json:"supports_image_input"`
+	SupportsParallelToolCalls bool                `json:"supports_parallel_tool_calls"`
+	SupportsReasoning         bool                `json:"supports_reasoning"`
+	DeprecationInfo           *LLMDeprecationInfo `json:"deprecation_info,omitempty"`
+}
+
+type LLMDeprecationInfo struct {
+	IsDeprecated        bool   `json:"is_deprecated"`
+	DeprecationDateUnix *int64 `json:"deprecation_date_unix,omitempty"`
+	ReplacementModel    string `json:"replacement_model,omitempty"`
+}
+
+func (c *Client) ListLLMs(ctx context.Context) ([]*LLMInfo, error) {
+	req, err := c.newRequest(ctx, "GET", fmt.Sprintf("%s/llm/list", apiBaseURL), nil)
+	if err != nil {
+		return nil, err
+	}
+	var list struct {
+		LLMs []*LLMInfo `json:"llms"`
+	}
+	_, err = c.do(req, &list)
+	return list.LLMs, err
+}
+
+// LLMCatalog returns the LLM catalog, fetching it on first use. Failed
+// fetches are not cached.
+func (c *Client) LLMCatalog(ctx context.Context) ([]*LLMInfo, error) {
+	c.llmCatalogMu.Lock()
+	defer c.llmCatalogMu.Unlock()
+
+	if c.llmCatalog != nil {
+		return c.llmCatalog, nil
+	}
+	llms, err := c.ListLLMs(ctx)
+	if err != nil {
+		return nil, err
+	}
+	c.llmCatalog = llms
+	return llms, nil
+}
+
+// Conversation Credentials
+
+// GetSignedURL returns a signed WebSocket URL that starts a conversation with
+// a private agent.
+func (c *Client) GetSignedURL(ctx context.Context, agentID string) (string, error) {
+	req, err := c.newRequest(ctx, "GET", fmt.Sprintf("%s/conversation/get-signed-url?agent_id=%s", apiBaseURL, url.QueryEscape(agentID)), nil)
+	if err != nil {
+		return "", err
+	}
+	var result struct {
+		SignedURL string `json:"signed_url"`
+	}
+	resp, err := c.do(req, &result)
+	if err != nil {
+		return "", err
+	}
+	if resp.StatusCode == http.StatusNotFound {
+		return "", fmt.Errorf("agent %s not found", agentID)
+	}
+	return result.SignedURL, nil
+}
+
+// GetConversationToken returns a WebRTC token that starts a conversation with
+// a private agent.
+func (c *Client) GetConversationToken(ctx context.Context, agentID string) (string, error) {
+	req, err := c.newRequest(ctx, "GET", fmt.Sprintf("%s/conversation/token?agent_id=%s", apiBaseURL, url.QueryEscape(agentID)), nil)
+	if err != nil {
+		return "", err
+	}
+	var result struct {
+		Token string `json:"token"`
+	}
+	resp, err := c.do(req, &result)
+	if err != nil {
+		return "", err
+	}
+	if resp.StatusCode == http.StatusNotFound {
+		return "", fmt.Errorf("agent %s not found", agentID)
+	}
+	return result.Token, nil
+}
+
+// Conversation
+type ConversationSummary struct {
+	AgentID           string `json:"agent_id"`
+	AgentName         string `json:"agent_name"`
+	ConversationID    string `json:"conversation_id"`
+	StartTimeUnixSecs int64  `json:"start_time_unix_secs"`
+	CallDurationSecs  int    `json:"call_duration_secs"`
+	MessageCount      int    `json:"message_count"`
+	Status            string `json:"status"`
+	CallSuccessful    string `json:"call_successful"`
+}
+
+// ConversationListFilter narrows ListConversations. Zero values are not
+// sent.
+type ConversationListFilter struct {
+	AgentID             string
+	CallSuccessful      string
+	CallStartAfterUnix  int64
+	CallStartBeforeUnix int64
+	// Status is matched client-side, as the API cannot filter on it.
+	Status string
+	// MaxResults stops the listing once that many conversations matched.
+	// Zero lists them all.
+	MaxResults int
+}
+
+type Conversation struct {
+	AgentID        string                         `json:"agent_id"`
+	ConversationID string                         `json:"conversation_id"`
+	Status         string                         `json:"status"`
+	Transcript     []*ConversationTranscriptEntry `json:"transcript"`
+	Metadata       *ConversationMetadata          `json:"metadata"`
+	Analysis       *ConversationAnalysis          `json:"analysis"`
+}
+
+type ConversationTranscriptEntry struct {
+	Role           string                    `json:"role"`
+	Message        string                    `json:"message"`
+	TimeInCallSecs int                       `json:"time_in_call_secs"`
+	ToolCalls      []*ConversationToolCall   `json:"tool_calls"`
+	ToolResults    []*ConversationToolResult `json:"tool_results"`
+}
+
+type ConversationToolCall struct {
+	RequestID    string `json:"request_id"`
+	ToolName     string `json:"tool_name"`
+	ParamsAsJSON string `json:"params_as_json"`
+}
+
+type ConversationToolResult struct {
+	RequestID   string `json:"request_id"`
+	ToolName    string `json:"tool_name"`
+	ResultValue string `json:"result_value"`
+	IsError     bool   `json:"is_error"`
+}
+
+type ConversationMetadata struct {
+	StartTimeUnixSecs int64                 `json:"start_time_unix_secs"`
+	CallDurationSecs  int                   `json:"call_duration_secs"`
+	TerminationReason string                `json:"termination_reason"`
+	Charging          *ConversationCharging `json:"charging"`
+}
+
+// ConversationCharging holds what a conversation cost. LLMPrice is in USD.
+type ConversationCharging struct {
+	LLMPrice   *float64 `json:"llm_price"`
+	LLMCharge  *int     `json:"llm_charge"`
+	CallCharge *int     `json:"call_charge"`
+}
+
+type ConversationAnalysis struct {
+	CallSuccessful            string                               `json:"call_successful"`
+	TranscriptSummary         string                               `json:"transcript_summary"`
+	EvaluationCriteriaResults map[string]*EvaluationCriteriaResult `json:"evaluation_criteria_results"`
+	DataCollectionResults     map[string]*DataCollectionResult     `json:"data_collection_results"`
+}
+
+type EvaluationCriteriaResult struct {
+	CriteriaID string `json:"criteria_id"`
+	Result     string `json:"result"`
+	Rationale  string `json:"rationale"`
+}
+
+type DataCollectionResult struct {
+	DataCollectionID string          `json:"data_collection_id"`
+	Value            json.RawMessage `json:"value"`
+	Rationale        string          `json:"rationale"`
+}
+
+// ListConversations returns the conversations matching filter, most recent
+// first, following the pagination cursor until the last page or until
+// filter.MaxResults conversations were found.
+func (c *Client) ListConversations(ctx context.Context, filter *ConversationListFilter) ([]*ConversationSummary, error) {
+	var conversations []*ConversationSummary
+	cursor := ""
+	for {
+		query := url.Values{}
+		query.Set("page_size", "100")
+		if filter.AgentID != "" {
+			query.Set("agent_id", filter.AgentID)
+		}
+		if filter.CallSuccessful != "" {
+			query.Set("call_successful", filter.CallSuccessful)
+		}
+		if filter.CallStartAfterUnix != 0 {
+			query.Set("call_start_after_unix", fmt.Sprint(filter.CallStartAfterUnix))
+		}
+		if filter.CallStartBeforeUnix != 0 {
+			query.Set("call_start_before_unix", fmt.Sprint(filter.CallStartBeforeUnix))
+		}
+		if cursor != "" {
+			query.Set("cursor", cursor)
+		}
+		req, err := c.newRequest(ctx, "GET", fmt.Sprintf("%s/conversations?%s", apiBaseURL, query.Encode()), nil)
+		if err != nil {
+			return nil, err
+		}
+		var page struct {
+			Conversations []*ConversationSummary `json:"conversations"`
+			NextCursor    string                 `json:"next_cursor"`
+			HasMore       bool                   `json:"has_more"`
+		}
+		if _, err := c.do(req, &page); err != nil {
+			return nil, err
+		}
+		for _, conversation := range page.Conversations {
+			if filter.Status != "" && conversation.Status != filter.Status {
+				continue
+			}
+			conversations = append(conversations, conversation)
+			if filter.MaxResults > 0 && len(conversations) == filter.MaxResults {
+				return conversations, nil
+			}
+		}
+		if !page.HasMore || page.NextCursor == "" {
+			return conversations, nil
+		}
+		cursor = page.NextCursor
+	}
+}
+
+func (c *Client) GetConversation(ctx context.Context, conversationID string) (*Conversation, error) {
+	req, err := c.newRequest(ctx, "GET", fmt.Sprintf("%s/conversations/%s", apiBaseURL, conversationID), nil)
+	if err != nil {
+		return nil, err
+	}
+	var conversation Conversation
+	resp, err := c.do(req, &conversation)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode == http.StatusNotFound {
+		return nil, nil
+	}
+	return &conversation, nil
+}
+
+// Subscription
+type Subscription struct {
+	Tier                        string `json:"tier"`
+	Status                      string `json:"status"`
+	CharacterCount              int    `json:"character_count"`
+	CharacterLimit              int    `json:"character_limit"`
+	NextCharacterCountResetUnix int64  `json:"next_character_count_reset_unix"`
+	VoiceSlotsUsed              int    `json:"voice_slots_used"`
+	VoiceLimit                  int    `json:"voice_limit"`
+	ProfessionalVoiceSlotsUsed  int    `json:"professional_voice_slots_used"`
+	ProfessionalVoiceLimit      int    `json:"professional_voice_limit"`
+	CanExtendCharacterLimit     bool   `json:"can_extend_character_limit"`
+	BillingPeriod               string `json:"billing_period"`
+}
+
+func (c *Client) GetSubscription(ctx context.Context) (*Subscription, error) {
+	req, err := c.newRequest(ctx, "GET", fmt.Sprintf("%s/user/subscription", apiRootURL), nil)
+	if err != nil {
+		return nil, err
+	}
+	var subscription Subscription
+	_, err = c.do(req, &subscription)
+	return &subscription, err
+}
+
+// Tool
+type LiteralJsonSchemaProperty struct {
+	Type        string `json:"type"`
+	Description string `json:"description,omitempty"`
+}
+
+type QueryParamsJsonSchema struct {
+	Properties map[string]LiteralJsonSchemaProperty `json:"properties"`
+	Required   []string                             `json:"required,omitempty"`
+}
+
+type APISchema struct {
+	URL               string                               `json:"url"`
+	Method            string                               `json:"method,omitempty"`
+	PathParamsSchema  map[string]LiteralJsonSchemaProperty `json:"path_params_schema,omitempty"`
+	QueryParamsSchema *QueryParamsJsonSchema               `json:"query_params_schema,omitempty"`
+	RequestBodySchema json.RawMessage                      `json:"request_body_schema,omitempty"`
+	RequestHeaders    map[string]string                    `json:"request_headers,omitempty"`
+}
+
+type Tool struct {
+	Name                 string     `json:"name"`
+	Description          string     `json:"description,omitempty"`
+	APISchema            *APISchema `json:"api_schema,omitempty"`
+	Type                 string     `json:"type,omitempty"`
+	ResponseTimeoutSecs  int        `json:"response_timeout_secs,omitempty"`
+	DisableInterruptions bool       `json:"disable_interruptions,omitempty"`
+	ForcePreToolSpeech   bool       `json:"force_pre_tool_speech,omitempty"`
+}
+
+type ToolRequest struct {
+	ToolConfig Tool `json:"tool_config"`
+}
+
+type ToolResponse struct {
+	ID         string `json:"id"`
+	ToolConfig Tool   `json:"tool_config"`
+}
+
+func (c *Client) CreateTool(ctx context.Context, tool *Tool) (*ToolResponse, error) {
+	toolRequest := ToolRequest{ToolConfig: *tool}
+	req, err := c.newRequest(ctx, "POST", fmt.Sprintf("%s/tools", apiBaseURL), &toolRequest)
+	if err != nil {
+		return nil, err
+	}
+	var createdTool ToolResponse
+	_, err = c.do(req, &createdTool)
+	return &createdTool, err
+}
+
+func (c *Client) GetTool(ctx context.Context, toolID string) (*ToolResponse, error) {
+	req, err := c.newRequest(ctx, "GET", fmt.Sprintf("%s/tools/%s", apiBaseURL, toolID), nil)
+	if err != nil {
+		return nil, err
+	}
+	var tool ToolResponse
+	resp, err := c.do(req, &tool)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode == http.StatusNotFound {
+		return nil, nil
+	}
+	return &tool, nil
+}
+
+// ListTools returns every tool matching search, following the pagination
+// cursor until the last page.
+func (c *Client) ListTools(ctx context.Context, search string) ([]*ToolResponse, error) {
+	var tools []*ToolResponse
+	cursor := ""
+	for {
+		query := url.Values{}
+		query.Set("page_size", "100")
+		if search != "" {
+			query.Set("search", search)
+		}
+		if cursor != "" {
+			query.Set("cursor", cursor)
+		}
+		req, err := c.newRequest(ctx, "GET", fmt.Sprintf("%s/tools?%s", apiBaseURL, query.Encode()), nil)
+		if err != nil {
+			return nil, err
+		}
+		var page struct {
+			Tools      []*ToolResponse `json:"tools"`
+			NextCursor string          `json:"next_cursor"`
+			HasMore    bool            `json:"has_more"`
+		}
+		if _, err := c.do(req, &page); err != nil {
+			return nil, err
+		}
+		tools = append(tools, page.Tools...)
+		if !page.HasMore || page.NextCursor == "" {
+			return tools, nil
+		}
+		cursor = page.NextCursor
+	}
+}
+
+// ToolDependentAgent is an agent that uses a tool. Agents the caller cannot
+// access are returned with type "unknown" and no ID.
+type ToolDependentAgent struct {
+	Type string `json:"type"`
+	ID   string `json:"id,omitempty"`
+	Name string `json:"name,omitempty"`
+}
+
+func (c *Client) GetToolDependentAgents(ctx context.Context, toolID string) ([]*ToolDependentAgent, error) {
+	var agents []*ToolDependentAgent
+	cursor := ""
+	for {
+		query := url.Values{}
+		query.Set("page_size", "100")
+		if cursor != "" {
+			query.Set("cursor", cursor)
+		}
+		req, err := c.newRequest(ctx, "GET", fmt.Sprintf("%s/tools/%s/dependent-agents?%s", apiBaseURL, toolID, query.Encode()), nil)
+		if err != nil {
+			return nil, err
+		}
+		var page struct {
+			Agents     []*ToolDependentAgent `json:"agents"`
+			NextCursor string                `json:"next_cursor"`
+			HasMore    bool                  `json:"has_more"`
+		}
+		if _, err := c.do(req, &page); err != nil {
+			return nil, err
+		}
+		agents = append(agents, page.Agents...)
+		if !page.HasMore || page.NextCursor == "" {
+			return agents, nil
+		}
+		cursor = page.NextCursor
+	}
+}
+
+func (c *Client) UpdateTool(ctx context.Context, toolID string, tool *Tool) error {
+	toolRequest := ToolRequest{ToolConfig: *tool}
+	req, err := c.newRequest(ctx, "PATCH", fmt.Sprintf("%s/tools/%s", apiBaseURL, toolID), &toolRequest)
+	if err != nil {
+		return err
+	}
+	_, err = c.do(req, nil)
+	return err
+}
+
+func (c *Client) DeleteTool(ctx context.Context, toolID string) error {
+	req, err := c.newRequest(ctx, "DELETE", fmt.Sprintf("%s/tools/%s", apiBaseURL, toolID), nil)
+	if err != nil {
+		return err
+	}
+	_, err = c.do(req, nil)
+	return err
+}
+
+// Pronunciation Dictionary
+type PronunciationDictionaryRule struct {
+	StringToReplace string `json:"string_to_replace"`
+	Type            string `json:"type"`
+	Alias           string `json:"alias,omitempty"`
+	Phoneme         string `json:"phoneme,omitempty"`
+	Alphabet        string `json:"alphabet,omitempty"`
+}
+
+type PronunciationDictionaryRequest struct {
+	Name        string                         `json:"name,omitempty"`
+	Description string                         `json:"description,omitempty"`
+	Rules       []*PronunciationDictionaryRule `json:"rules"`
+}
+
+type PronunciationDictionary struct {
+	ID                    string `json:"id"`
+	Name                  string `json:"name"`
+	Description           string `json:"description,omitempty"`
+	LatestVersionID       string `json:"latest_version_id,omitempty"`
+	LatestVersionRulesNum int    `json:"latest_version_rules_num,omitempty"`
+	ArchivedTimeUnix      *int64 `json:"archived_time_unix,omitempty"`
+}
+
+// PronunciationDictionaryVersion is returned by the endpoints that create a
+// new dictionary version.
+type PronunciationDictionaryVersion struct {
+	ID              string `json:"id"`
+	VersionID       string `json:"version_id"`
+	VersionRulesNum int    `json:"version_rules_num"`
+}
+
+func (c *Client) CreatePronunciationDictionary(ctx context.Context, dict *PronunciationDictionaryRequest) (*PronunciationDictionaryVersion, error) {
+	req, err := c.newRequest(ctx, "POST", fmt.Sprintf("%s/pronunciation-dictionaries/add-from-rules", apiRootURL), dict)
+	if err != nil {
+		return nil, err
+	}
+	var version PronunciationDictionaryVersion
+	_, err = c.do(req, &version)
+	return &version, err
+}
+
+func (c *Client) GetPronunciationDictionary(ctx context.Context, dictionaryID string) (*PronunciationDictionary, error) {
+	req, err := c.newRequest(ctx, "GET", fmt.Sprintf("%s/pronunciation-dictionaries/%s", apiRootURL, dictionaryID), nil)
+	if err != nil {
+		return nil, err
+	}
+	var dict PronunciationDictionary
+	resp, err := c.do(req, &dict)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode == http.StatusNotFound || dict.ArchivedTimeUnix != nil {
+		return nil, nil
+	}
+	return &dict, nil
+}
+
+func (c *Client) SetPronunciationDictionaryRules(ctx context.Context, dictionaryID string, rules []*PronunciationDictionaryRule) (*PronunciationDictionaryVersion, error) {
+	body := map[string]interface{}{"rules": rules}
+	req, err := c.newRequest(ctx, "POST", fmt.Sprintf("%s/pronunciation-dictionaries/%s/set-rules", apiRootURL, dictionaryID), body)
+	if err != nil {
+		return nil, err
+	}
+	var version PronunciationDictionaryVersion
+	_, err = c.do(req, &version)
+	return &version, err
+}
+
+// DownloadPronunciationDictionaryVersion returns a dictionary version as a
+// PLS document.
+func (c *Client) DownloadPronunciationDictionaryVersion(ctx context.Context, dictionaryID, versionID string) ([]byte, error) {
+	req, err := c.newRequest(ctx, "GET", fmt.Sprintf("%s/pronunciation-dictionaries/%s/%s/download", apiRootURL, dictionaryID, versionID), nil)
+	if err != nil {
+		return nil, err
+	}
+	var content []byte
+	resp, err := c.do(req, &content)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode == http.StatusNotFound {
+		return nil, nil
+	}
+	return content, nil
+}
+
+func (c *Client) UpdatePronunciationDictionary(ctx context.Context, dictionaryID string, name, description string) error {
+	body := map[string]interface{}{"name": name, "description": description}
+	req, err := c.newRequest(ctx, "PATCH", fmt.Sprintf("%s/pronunciation-dictionaries/%s", apiRootURL, dictionaryID), body)
+	if err != nil {
+		return err
+	}
+	_, err = c.do(req, nil)
+	return err
+}
+
+// ArchivePronunciationDictionary archives a dictionary; the API does not
+// support deleting them outright.
+func (c *Client) ArchivePronunciationDictionary(ctx context.Context, dictionaryID string) error {
+	body := map[string]interface{}{"archived": true}
+	req, err := c.newRequest(ctx, "PATCH", fmt.Sprintf("%s/pronunciation-dictionaries/%s", apiRootURL, dictionaryID), body)
 	if err != nil {
 		return err
 	}
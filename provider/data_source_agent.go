@@ -0,0 +1,77 @@
+package provider
+
+import (
+	"context"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceAgent() *schema.Resource {
+	s := dataSourceSchemaFromResourceSchema(resourceAgent().Schema)
+	delete(s, "run_tests_on_apply")
+	delete(s, "pronunciation_dictionary_versions")
+	s["agent_id"] = &schema.Schema{
+		Type:         schema.TypeString,
+		Optional:     true,
+		Computed:     true,
+		ExactlyOneOf: []string{"agent_id", "name"},
+	}
+	s["name"] = &schema.Schema{
+		Type:         schema.TypeString,
+		Optional:     true,
+		Computed:     true,
+		Description:  "The exact name of the agent. The lookup fails when no agent or more than one agent has this name.",
+		ExactlyOneOf: []string{"agent_id", "name"},
+	}
+
+	return &schema.Resource{
+		ReadContext: dataSourceAgentRead,
+		Description: "Looks up an agent by ID or name.",
+		Schema:      s,
+	}
+}
+
+func dataSourceAgentRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*Client)
+
+	agentID := d.Get("agent_id").(string)
+	if agentID == "" {
+		name := d.Get("name").(string)
+		agents, err := client.ListAgents(ctx, name)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+
+		var matches []string
+		for _, agent := range agents {
+			if agent.Name == name {
+				matches = append(matches, agent.AgentID)
+			}
+		}
+		switch len(matches) {
+		case 0:
+			return diag.Errorf("no agent named %q found", name)
+		case 1:
+			agentID = matches[0]
+		default:
+			return diag.Errorf("%d agents are named %q (%s), use agent_id to select one", len(matches), name, strings.Join(matches, ", "))
+		}
+	}
+
+	agent, err := client.GetAgent(ctx, agentID)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if agent == nil {
+		return diag.Errorf("agent %q not found", agentID)
+	}
+
+	d.SetId(agentID)
+	if err := setAgentAttributes(d, agent); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
@@ -0,0 +1,135 @@
+package provider
+
+import (
+	"context"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceAgents() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceAgentsRead,
+		Description: "Lists the agents in the workspace.",
+		Schema: map[string]*schema.Schema{
+			"search": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Only list agents whose name contains this text.",
+			},
+			"tags": {
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Description: "Only list agents that have all of these tags.",
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"created_by": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Only list agents created by the user with this email address or name.",
+			},
+			"agents": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"agent_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"tags": {
+							Type:     schema.TypeSet,
+							Computed: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"created_at_unix_secs": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"creator_name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"creator_email": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"ids": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+		},
+	}
+}
+
+func dataSourceAgentsRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*Client)
+	search := d.Get("search").(string)
+	tags := expandStringSet(d.Get("tags"))
+	createdBy := d.Get("created_by").(string)
+
+	agents, err := client.ListAgents(ctx, search)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	var agentList []interface{}
+	ids := []string{}
+	for _, agent := range agents {
+		if !hasAllTags(agent.Tags, tags) {
+			continue
+		}
+		var creatorName, creatorEmail string
+		if agent.AccessInfo != nil {
+			creatorName = agent.AccessInfo.CreatorName
+			creatorEmail = agent.AccessInfo.CreatorEmail
+		}
+		if createdBy != "" && !strings.EqualFold(createdBy, creatorEmail) && !strings.EqualFold(createdBy, creatorName) {
+			continue
+		}
+
+		agentList = append(agentList, map[string]interface{}{
+			"agent_id":             agent.AgentID,
+			"name":                 agent.Name,
+			"tags":                 agent.Tags,
+			"created_at_unix_secs": int(agent.CreatedAtUnixSecs),
+			"creator_name":         creatorName,
+			"creator_email":        creatorEmail,
+		})
+		ids = append(ids, agent.AgentID)
+	}
+
+	d.SetId(dataSourceID(append([]string{search, createdBy}, tags...)...))
+	if err := d.Set("agents", agentList); err != nil {
+		return diag.FromErr(err)
+	}
+	d.Set("ids", ids)
+
+	return nil
+}
+
+// hasAllTags reports whether tags contains every tag in want.
+func hasAllTags(tags, want []string) bool {
+	for _, w := range want {
+		found := false
+		for _, tag := range tags {
+			if tag == w {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+	return true
+}
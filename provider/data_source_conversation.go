@@ -0,0 +1,261 @@
+package provider
+
+import (
+	"context"
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceConversation() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceConversationRead,
+		Description: "Gets the transcript and analysis of a conversation.",
+		Schema: map[string]*schema.Schema{
+			"conversation_id": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"agent_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"start_time_unix_secs": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"call_duration_secs": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"termination_reason": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"transcript": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"role": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Either `user` or `agent`.",
+						},
+						"message": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"time_in_call_secs": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"analysis": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"call_successful": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"transcript_summary": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"evaluation_criteria_results": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"criteria_id": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"result": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"rationale": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"data_collection_results": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"data_collection_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"value": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The collected value, encoded as JSON.",
+						},
+						"rationale": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"tool_calls": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The tools the agent called during the conversation, in order, with their results.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"request_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"tool_name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"params_json": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"result_value": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"is_error": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+						"time_in_call_secs": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceConversationRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*Client)
+	conversationID := d.Get("conversation_id").(string)
+
+	conversation, err := client.GetConversation(ctx, conversationID)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if conversation == nil {
+		return diag.Errorf("conversation %q not found", conversationID)
+	}
+
+	d.SetId(conversationID)
+	d.Set("agent_id", conversation.AgentID)
+	d.Set("status", conversation.Status)
+	if conversation.Metadata != nil {
+		d.Set("start_time_unix_secs", int(conversation.Metadata.StartTimeUnixSecs))
+		d.Set("call_duration_secs", conversation.Metadata.CallDurationSecs)
+		d.Set("termination_reason", conversation.Metadata.TerminationReason)
+	}
+
+	transcript, toolCalls := flattenConversationTranscript(conversation.Transcript)
+	if err := d.Set("transcript", transcript); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("tool_calls", toolCalls); err != nil {
+		return diag.FromErr(err)
+	}
+
+	var analysis, dataCollectionResults []interface{}
+	if a := conversation.Analysis; a != nil {
+		criteriaIDs := make([]string, 0, len(a.EvaluationCriteriaResults))
+		for id := range a.EvaluationCriteriaResults {
+			criteriaIDs = append(criteriaIDs, id)
+		}
+		sort.Strings(criteriaIDs)
+
+		var criteriaResults []interface{}
+		for _, id := range criteriaIDs {
+			result := a.EvaluationCriteriaResults[id]
+			criteriaResults = append(criteriaResults, map[string]interface{}{
+				"criteria_id": id,
+				"result":      result.Result,
+				"rationale":   result.Rationale,
+			})
+		}
+		analysis = []interface{}{map[string]interface{}{
+			"call_successful":             a.CallSuccessful,
+			"transcript_summary":          a.TranscriptSummary,
+			"evaluation_criteria_results": criteriaResults,
+		}}
+
+		dataCollectionIDs := make([]string, 0, len(a.DataCollectionResults))
+		for id := range a.DataCollectionResults {
+			dataCollectionIDs = append(dataCollectionIDs, id)
+		}
+		sort.Strings(dataCollectionIDs)
+
+		for _, id := range dataCollectionIDs {
+			result := a.DataCollectionResults[id]
+			dataCollectionResults = append(dataCollectionResults, map[string]interface{}{
+				"data_collection_id": id,
+				"value":              string(result.Value),
+				"rationale":          result.Rationale,
+			})
+		}
+	}
+	if err := d.Set("analysis", analysis); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("data_collection_results", dataCollectionResults); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+// flattenConversationTranscript returns the transcript messages and the tool
+// calls made along the way, each matched with its result.
+func flattenConversationTranscript(entries []*ConversationTranscriptEntry) ([]interface{}, []interface{}) {
+	var transcript, toolCalls []interface{}
+	callsByRequestID := make(map[string]map[string]interface{})
+	for _, entry := range entries {
+		transcript = append(transcript, map[string]interface{}{
+			"role":              entry.Role,
+			"message":           entry.Message,
+			"time_in_call_secs": entry.TimeInCallSecs,
+		})
+		for _, call := range entry.ToolCalls {
+			toolCall := map[string]interface{}{
+				"request_id":        call.RequestID,
+				"tool_name":         call.ToolName,
+				"params_json":       call.ParamsAsJSON,
+				"time_in_call_secs": entry.TimeInCallSecs,
+			}
+			callsByRequestID[call.RequestID] = toolCall
+			toolCalls = append(toolCalls, toolCall)
+		}
+		for _, result := range entry.ToolResults {
+			if toolCall, ok := callsByRequestID[result.RequestID]; ok {
+				toolCall["result_value"] = result.ResultValue
+				toolCall["is_error"] = result.IsError
+			}
+		}
+	}
+	return transcript, toolCalls
+}
@@ -0,0 +1,164 @@
+package provider
+
+import (
+	"context"
+	"strconv"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func dataSourceConversations() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceConversationsRead,
+		Description: "Lists the conversations of the workspace, for example to check that an agent completed enough successful calls after a deployment.",
+		Schema: map[string]*schema.Schema{
+			"agent_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"status": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Description:  "Only list conversations with this status. The API cannot filter on the status, so every conversation matching the other arguments is fetched until `max_results` is reached.",
+				ValidateFunc: validation.StringInSlice([]string{"initiated", "in-progress", "processing", "done", "failed"}, false),
+			},
+			"call_successful": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Description:  "Only list conversations with this evaluation result.",
+				ValidateFunc: validation.StringInSlice([]string{"success", "failure", "unknown"}, false),
+			},
+			"start_after": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Description:  "Only list conversations started after this RFC 3339 timestamp.",
+				ValidateFunc: validation.IsRFC3339Time,
+			},
+			"start_before": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Description:  "Only list conversations started before this RFC 3339 timestamp.",
+				ValidateFunc: validation.IsRFC3339Time,
+			},
+			"max_results": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      100,
+				Description:  "The maximum number of conversations to list, most recent first.",
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+			"conversations": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"conversation_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"agent_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"agent_name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"start_time_unix_secs": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"call_duration_secs": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"message_count": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"status": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"call_successful": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"ids": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+		},
+	}
+}
+
+func dataSourceConversationsRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*Client)
+
+	filter, err := expandConversationListFilter(d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	filter.CallSuccessful = d.Get("call_successful").(string)
+	filter.Status = d.Get("status").(string)
+	filter.MaxResults = d.Get("max_results").(int)
+
+	conversations, err := client.ListConversations(ctx, filter)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	var conversationList []interface{}
+	ids := []string{}
+	for _, conversation := range conversations {
+		conversationList = append(conversationList, map[string]interface{}{
+			"conversation_id":      conversation.ConversationID,
+			"agent_id":             conversation.AgentID,
+			"agent_name":           conversation.AgentName,
+			"start_time_unix_secs": int(conversation.StartTimeUnixSecs),
+			"call_duration_secs":   conversation.CallDurationSecs,
+			"message_count":        conversation.MessageCount,
+			"status":               conversation.Status,
+			"call_successful":      conversation.CallSuccessful,
+		})
+		ids = append(ids, conversation.ConversationID)
+	}
+
+	d.SetId(dataSourceID(filter.AgentID, filter.Status, filter.CallSuccessful, d.Get("start_after").(string), d.Get("start_before").(string), strconv.Itoa(filter.MaxResults)))
+	if err := d.Set("conversations", conversationList); err != nil {
+		return diag.FromErr(err)
+	}
+	d.Set("ids", ids)
+
+	return nil
+}
+
+// expandConversationListFilter reads the agent_id, start_after and
+// start_before arguments shared by the conversations and usage data sources.
+func expandConversationListFilter(d *schema.ResourceData) (*ConversationListFilter, error) {
+	filter := &ConversationListFilter{
+		AgentID: d.Get("agent_id").(string),
+	}
+	if v, ok := d.GetOk("start_after"); ok {
+		t, err := time.Parse(time.RFC3339, v.(string))
+		if err != nil {
+			return nil, err
+		}
+		filter.CallStartAfterUnix = t.Unix()
+	}
+	if v, ok := d.GetOk("start_before"); ok {
+		t, err := time.Parse(time.RFC3339, v.(string))
+		if err != nil {
+			return nil, err
+		}
+		filter.CallStartBeforeUnix = t.Unix()
+	}
+	return filter, nil
+}
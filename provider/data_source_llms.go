@@ -0,0 +1,123 @@
+package provider
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceLLMs() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceLLMsRead,
+		Description: "Lists the LLMs agents can use. `elevenlabs_agent` only warns about a deprecated `prompt.llm` after apply; to catch one during plan, check `is_deprecated` in a precondition or `check` block.",
+		Schema: map[string]*schema.Schema{
+			"include_deprecated": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+			"llms": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"llm": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The model name to use in `prompt.llm`.",
+						},
+						"max_tokens_limit": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"max_context_limit": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"supports_image_input": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+						"supports_parallel_tool_calls": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+						"supports_reasoning": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+						"is_deprecated": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+						"deprecation_date_unix": {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: "When the model stops working, or 0 when no date is set.",
+						},
+						"replacement_model": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"ids": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+		},
+	}
+}
+
+func dataSourceLLMsRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*Client)
+	includeDeprecated := d.Get("include_deprecated").(bool)
+
+	catalog, err := client.LLMCatalog(ctx)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	var llmList []interface{}
+	ids := []string{}
+	for _, info := range catalog {
+		isDeprecated := false
+		deprecationDate := 0
+		replacement := ""
+		if info.DeprecationInfo != nil {
+			isDeprecated = info.DeprecationInfo.IsDeprecated
+			replacement = info.DeprecationInfo.ReplacementModel
+			if info.DeprecationInfo.DeprecationDateUnix != nil {
+				deprecationDate = int(*info.DeprecationInfo.DeprecationDateUnix)
+			}
+		}
+		if isDeprecated && !includeDeprecated {
+			continue
+		}
+
+		llmList = append(llmList, map[string]interface{}{
+			"llm":                          info.LLM,
+			"max_tokens_limit":             info.MaxTokensLimit,
+			"max_context_limit":            info.MaxContextLimit,
+			"supports_image_input":         info.SupportsImageInput,
+			"supports_parallel_tool_calls": info.SupportsParallelToolCalls,
+			"supports_reasoning":           info.SupportsReasoning,
+			"is_deprecated":                isDeprecated,
+			"deprecation_date_unix":        deprecationDate,
+			"replacement_model":            replacement,
+		})
+		ids = append(ids, info.LLM)
+	}
+
+	d.SetId(dataSourceID(strconv.FormatBool(includeDeprecated)))
+	if err := d.Set("llms", llmList); err != nil {
+		return diag.FromErr(err)
+	}
+	d.Set("ids", ids)
+
+	return nil
+}
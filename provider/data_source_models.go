@@ -0,0 +1,129 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceModels() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceModelsRead,
+		Description: "Lists the text to speech models.",
+		Schema: map[string]*schema.Schema{
+			"language": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Only list models that support this language ID, such as `en` or `de`.",
+			},
+			"models": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"model_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"description": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"languages": {
+							Type:        schema.TypeList,
+							Computed:    true,
+							Description: "The IDs of the languages the model supports.",
+							Elem:        &schema.Schema{Type: schema.TypeString},
+						},
+						"can_do_voice_conversion": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+						"can_use_style": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+						"can_use_speaker_boost": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+						"serves_pro_voices": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+						"token_cost_factor": {
+							Type:     schema.TypeFloat,
+							Computed: true,
+						},
+						"maximum_text_length_per_request": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"ids": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+		},
+	}
+}
+
+func dataSourceModelsRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*Client)
+	language := d.Get("language").(string)
+
+	models, err := client.ListModels(ctx)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	var modelList []interface{}
+	ids := []string{}
+	for _, model := range models {
+		if !model.CanDoTextToSpeech {
+			continue
+		}
+
+		languages := make([]string, 0, len(model.Languages))
+		supported := language == ""
+		for _, lang := range model.Languages {
+			languages = append(languages, lang.LanguageID)
+			if lang.LanguageID == language {
+				supported = true
+			}
+		}
+		if !supported {
+			continue
+		}
+
+		modelList = append(modelList, map[string]interface{}{
+			"model_id":                        model.ModelID,
+			"name":                            model.Name,
+			"description":                     model.Description,
+			"languages":                       languages,
+			"can_do_voice_conversion":         model.CanDoVoiceConversion,
+			"can_use_style":                   model.CanUseStyle,
+			"can_use_speaker_boost":           model.CanUseSpeakerBoost,
+			"serves_pro_voices":               model.ServesProVoices,
+			"token_cost_factor":               model.TokenCostFactor,
+			"maximum_text_length_per_request": model.MaximumTextLengthPerRequest,
+		})
+		ids = append(ids, model.ModelID)
+	}
+
+	d.SetId(dataSourceID(language))
+	if err := d.Set("models", modelList); err != nil {
+		return diag.FromErr(err)
+	}
+	d.Set("ids", ids)
+
+	return nil
+}
@@ -0,0 +1,148 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceOpenAPITools() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceOpenAPIToolsRead,
+		Description: "Reads a local OpenAPI 3 document and produces one webhook tool definition per operation, to be passed to `elevenlabs_tool` with `for_each`. Path parameters become `path_params_schema`, query parameters `query_params_schema` and JSON request bodies `request_body_schema`. Header and cookie parameters are left out, with a warning for required ones, which have to be set in `request_headers`. Tool parameters only have a type and a description, so the `enum` and other constraints of path and query parameters are dropped.",
+		Schema: map[string]*schema.Schema{
+			"path": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Path to the OpenAPI document, in JSON or YAML.",
+			},
+			"base_url": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The URL the operation paths are appended to. Defaults to the first server of the document.",
+			},
+			"include_tags": {
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Description: "Only include operations with one of these tags, in addition to those in `include_operation_ids`.",
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"exclude_tags": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"include_operation_ids": {
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Description: "Only include these operations, in addition to those in `include_tags`.",
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"exclude_operation_ids": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"tools": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"operation_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"name": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The tool name, derived from the operationId.",
+						},
+						"description": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"tags": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"api_schema": dataSourceSchemaFromResourceAttribute(resourceTool().Schema["api_schema"]),
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceOpenAPIToolsRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	path := d.Get("path").(string)
+
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	doc, err := parseOpenAPIDocument(content)
+	if err != nil {
+		return diag.Errorf("%s: %s", path, err)
+	}
+
+	baseURL := d.Get("base_url").(string)
+	if baseURL == "" {
+		baseURL = openAPIServerURL(doc)
+	}
+	if baseURL == "" {
+		return diag.Errorf("%s declares no servers, set base_url", path)
+	}
+
+	filter := &OpenAPIOperationFilter{
+		IncludeTags:         expandStringSet(d.Get("include_tags")),
+		ExcludeTags:         expandStringSet(d.Get("exclude_tags")),
+		IncludeOperationIDs: expandStringSet(d.Get("include_operation_ids")),
+		ExcludeOperationIDs: expandStringSet(d.Get("exclude_operation_ids")),
+	}
+	operations, err := openAPIOperations(doc, baseURL, filter)
+	if err != nil {
+		return diag.Errorf("%s: %s", path, err)
+	}
+
+	var diags diag.Diagnostics
+	var tools []interface{}
+	for _, operation := range operations {
+		for _, warning := range operation.Warnings {
+			diags = append(diags, diag.Diagnostic{
+				Severity: diag.Warning,
+				Summary:  fmt.Sprintf("Incomplete tool %s", operation.Name),
+				Detail:   fmt.Sprintf("%s: %s.", path, warning),
+			})
+		}
+		apiSchema, err := flattenAPISchema(operation.APISchema, nil)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		tools = append(tools, map[string]interface{}{
+			"operation_id": operation.OperationID,
+			"name":         operation.Name,
+			"description":  operation.Description,
+			"tags":         operation.Tags,
+			"api_schema":   []interface{}{apiSchema},
+		})
+	}
+
+	d.SetId(dataSourceID(
+		path,
+		baseURL,
+		strings.Join(filter.IncludeTags, ","),
+		strings.Join(filter.ExcludeTags, ","),
+		strings.Join(filter.IncludeOperationIDs, ","),
+		strings.Join(filter.ExcludeOperationIDs, ","),
+	))
+	if err := d.Set("tools", tools); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return diags
+}
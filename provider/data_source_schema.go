@@ -0,0 +1,44 @@
+package provider
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// dataSourceSchemaFromResourceSchema converts a resource schema into one
+// where every attribute is computed, so data sources can expose the same
+// attributes as the resources they look up and reuse their flatten helpers.
+func dataSourceSchemaFromResourceSchema(rs map[string]*schema.Schema) map[string]*schema.Schema {
+	ds := make(map[string]*schema.Schema, len(rs))
+	for k, v := range rs {
+		ds[k] = dataSourceSchemaFromResourceAttribute(v)
+	}
+	return ds
+}
+
+func dataSourceSchemaFromResourceAttribute(rs *schema.Schema) *schema.Schema {
+	ds := &schema.Schema{
+		Type:        rs.Type,
+		Description: rs.Description,
+		Sensitive:   rs.Sensitive,
+		Set:         rs.Set,
+		Computed:    true,
+	}
+	switch elem := rs.Elem.(type) {
+	case *schema.Schema:
+		ds.Elem = &schema.Schema{Type: elem.Type}
+	case *schema.Resource:
+		ds.Elem = &schema.Resource{Schema: dataSourceSchemaFromResourceSchema(elem.Schema)}
+	}
+	return ds
+}
+
+// dataSourceID derives a stable ID for list data sources from their filter
+// arguments.
+func dataSourceID(parts ...string) string {
+	sum := sha256.Sum256([]byte(strings.Join(parts, "\x00")))
+	return hex.EncodeToString(sum[:8])
+}
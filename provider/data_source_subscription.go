@@ -0,0 +1,86 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceSubscription() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceSubscriptionRead,
+		Description: "Gets the subscription of the account, for example to check the remaining character quota in a precondition.",
+		Schema: map[string]*schema.Schema{
+			"tier": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"billing_period": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"character_count": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "The characters used in the current billing period.",
+			},
+			"character_limit": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"can_extend_character_limit": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"next_character_count_reset_unix": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"voice_slots_used": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"voice_limit": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"professional_voice_slots_used": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"professional_voice_limit": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourceSubscriptionRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*Client)
+
+	subscription, err := client.GetSubscription(ctx)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId("subscription")
+	d.Set("tier", subscription.Tier)
+	d.Set("status", subscription.Status)
+	d.Set("billing_period", subscription.BillingPeriod)
+	d.Set("character_count", subscription.CharacterCount)
+	d.Set("character_limit", subscription.CharacterLimit)
+	d.Set("can_extend_character_limit", subscription.CanExtendCharacterLimit)
+	d.Set("next_character_count_reset_unix", int(subscription.NextCharacterCountResetUnix))
+	d.Set("voice_slots_used", subscription.VoiceSlotsUsed)
+	d.Set("voice_limit", subscription.VoiceLimit)
+	d.Set("professional_voice_slots_used", subscription.ProfessionalVoiceSlotsUsed)
+	d.Set("professional_voice_limit", subscription.ProfessionalVoiceLimit)
+
+	return nil
+}
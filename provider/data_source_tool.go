@@ -0,0 +1,105 @@
+package provider
+
+import (
+	"context"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceTool() *schema.Resource {
+	s := dataSourceSchemaFromResourceSchema(resourceTool().Schema)
+	s["tool_id"] = &schema.Schema{
+		Type:         schema.TypeString,
+		Optional:     true,
+		Computed:     true,
+		ExactlyOneOf: []string{"tool_id", "name"},
+	}
+	s["name"] = &schema.Schema{
+		Type:         schema.TypeString,
+		Optional:     true,
+		Computed:     true,
+		Description:  "The exact name of the tool. The lookup fails when no tool or more than one tool has this name.",
+		ExactlyOneOf: []string{"tool_id", "name"},
+	}
+	s["dependent_agent_ids"] = dependentAgentIDsSchema()
+
+	return &schema.Resource{
+		ReadContext: dataSourceToolRead,
+		Description: "Looks up a tool by ID or name.",
+		Schema:      s,
+	}
+}
+
+func dependentAgentIDsSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeList,
+		Computed:    true,
+		Description: "The IDs of the agents that use the tool. Agents you cannot access are left out.",
+		Elem:        &schema.Schema{Type: schema.TypeString},
+	}
+}
+
+func dataSourceToolRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*Client)
+
+	toolID := d.Get("tool_id").(string)
+	if toolID == "" {
+		name := d.Get("name").(string)
+		tools, err := client.ListTools(ctx, name)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+
+		var matches []string
+		for _, tool := range tools {
+			if tool.ToolConfig.Name == name {
+				matches = append(matches, tool.ID)
+			}
+		}
+		switch len(matches) {
+		case 0:
+			return diag.Errorf("no tool named %q found", name)
+		case 1:
+			toolID = matches[0]
+		default:
+			return diag.Errorf("%d tools are named %q (%s), use tool_id to select one", len(matches), name, strings.Join(matches, ", "))
+		}
+	}
+
+	tool, err := client.GetTool(ctx, toolID)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if tool == nil {
+		return diag.Errorf("tool %q not found", toolID)
+	}
+
+	d.SetId(toolID)
+	if err := setToolAttributes(d, tool); err != nil {
+		return diag.FromErr(err)
+	}
+
+	agentIDs, err := toolDependentAgentIDs(ctx, client, toolID)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	d.Set("dependent_agent_ids", agentIDs)
+
+	return nil
+}
+
+func toolDependentAgentIDs(ctx context.Context, client *Client, toolID string) ([]string, error) {
+	agents, err := client.GetToolDependentAgents(ctx, toolID)
+	if err != nil {
+		return nil, err
+	}
+	agentIDs := []string{}
+	for _, agent := range agents {
+		if agent.ID != "" {
+			agentIDs = append(agentIDs, agent.ID)
+		}
+	}
+	return agentIDs, nil
+}
@@ -0,0 +1,102 @@
+package provider
+
+import (
+	"context"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceTools() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceToolsRead,
+		Description: "Lists the tools in the workspace.",
+		Schema: map[string]*schema.Schema{
+			"type": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Only list tools of this type, such as `webhook` or `client`.",
+			},
+			"name_prefix": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Only list tools whose name starts with this prefix.",
+			},
+			"tools": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"tool_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"description": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"dependent_agent_ids": dependentAgentIDsSchema(),
+					},
+				},
+			},
+			"ids": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+		},
+	}
+}
+
+func dataSourceToolsRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*Client)
+	toolType := d.Get("type").(string)
+	namePrefix := d.Get("name_prefix").(string)
+
+	tools, err := client.ListTools(ctx, namePrefix)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	var toolList []interface{}
+	ids := []string{}
+	for _, tool := range tools {
+		if toolType != "" && tool.ToolConfig.Type != toolType {
+			continue
+		}
+		if !strings.HasPrefix(tool.ToolConfig.Name, namePrefix) {
+			continue
+		}
+
+		agentIDs, err := toolDependentAgentIDs(ctx, client, tool.ID)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+
+		toolList = append(toolList, map[string]interface{}{
+			"tool_id":             tool.ID,
+			"name":                tool.ToolConfig.Name,
+			"description":         tool.ToolConfig.Description,
+			"type":                tool.ToolConfig.Type,
+			"dependent_agent_ids": agentIDs,
+		})
+		ids = append(ids, tool.ID)
+	}
+
+	d.SetId(dataSourceID(toolType, namePrefix))
+	if err := d.Set("tools", toolList); err != nil {
+		return diag.FromErr(err)
+	}
+	d.Set("ids", ids)
+
+	return nil
+}
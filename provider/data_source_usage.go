@@ -0,0 +1,232 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strconv"
+	"sync"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func dataSourceUsage() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceUsageRead,
+		Description: "Sums the conversational AI minutes and LLM cost of the conversations in a time window, per agent. The API has no aggregate of the LLM cost, so it is read from every conversation in the window, one request each, on every plan that reads this data source. Keep the window short, and `max_conversations` low, for busy workspaces.",
+		Schema: map[string]*schema.Schema{
+			"agent_id": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Only count the conversations of this agent.",
+			},
+			"start_after": {
+				Type:         schema.TypeString,
+				Required:     true,
+				Description:  "Only count conversations started after this RFC 3339 timestamp.",
+				ValidateFunc: validation.IsRFC3339Time,
+			},
+			"start_before": {
+				Type:         schema.TypeString,
+				Required:     true,
+				Description:  "Only count conversations started before this RFC 3339 timestamp.",
+				ValidateFunc: validation.IsRFC3339Time,
+			},
+			"max_conversations": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      100,
+				Description:  "Fail rather than read more than this many conversations. Every conversation costs one API request per read. Shorten the window or raise the limit when it is reached.",
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+			"conversation_count": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"minutes": {
+				Type:     schema.TypeFloat,
+				Computed: true,
+			},
+			"llm_cost": {
+				Type:        schema.TypeFloat,
+				Computed:    true,
+				Description: "The LLM cost in USD.",
+			},
+			"agents": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"agent_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"agent_name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"conversation_count": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"minutes": {
+							Type:     schema.TypeFloat,
+							Computed: true,
+						},
+						"llm_cost": {
+							Type:        schema.TypeFloat,
+							Computed:    true,
+							Description: "The LLM cost in USD.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+type agentUsage struct {
+	agentName         string
+	conversationCount int
+	callDurationSecs  int
+	llmCost           float64
+}
+
+func dataSourceUsageRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*Client)
+
+	filter, err := expandConversationListFilter(d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	maxConversations := d.Get("max_conversations").(int)
+	// List one more than allowed to tell a full window from an overflowing
+	// one.
+	filter.MaxResults = maxConversations + 1
+
+	conversations, err := client.ListConversations(ctx, filter)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if len(conversations) > maxConversations {
+		return diag.Errorf("more than %d conversations match, shorten the window or raise max_conversations", maxConversations)
+	}
+
+	details, err := getConversations(ctx, client, conversations)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	var diags diag.Diagnostics
+	usageByAgent := make(map[string]*agentUsage)
+	total := &agentUsage{}
+	for i, summary := range conversations {
+		usage, ok := usageByAgent[summary.AgentID]
+		if !ok {
+			usage = &agentUsage{agentName: summary.AgentName}
+			usageByAgent[summary.AgentID] = usage
+		}
+
+		conversation := details[i]
+		if conversation == nil {
+			diags = append(diags, diag.Diagnostic{
+				Severity: diag.Warning,
+				Summary:  "Conversation not found",
+				Detail:   fmt.Sprintf("Conversation %s was listed but could not be read, its LLM cost is not included.", summary.ConversationID),
+			})
+		}
+		llmCost := 0.0
+		if conversation != nil && conversation.Metadata != nil && conversation.Metadata.Charging != nil && conversation.Metadata.Charging.LLMPrice != nil {
+			llmCost = *conversation.Metadata.Charging.LLMPrice
+		}
+
+		for _, u := range []*agentUsage{usage, total} {
+			u.conversationCount++
+			u.callDurationSecs += summary.CallDurationSecs
+			u.llmCost += llmCost
+		}
+	}
+
+	agentIDs := make([]string, 0, len(usageByAgent))
+	for agentID := range usageByAgent {
+		agentIDs = append(agentIDs, agentID)
+	}
+	sort.Strings(agentIDs)
+
+	var agentList []interface{}
+	for _, agentID := range agentIDs {
+		usage := usageByAgent[agentID]
+		agentList = append(agentList, map[string]interface{}{
+			"agent_id":           agentID,
+			"agent_name":         usage.agentName,
+			"conversation_count": usage.conversationCount,
+			"minutes":            float64(usage.callDurationSecs) / 60,
+			"llm_cost":           usage.llmCost,
+		})
+	}
+
+	d.SetId(dataSourceID(filter.AgentID, d.Get("start_after").(string), d.Get("start_before").(string), strconv.Itoa(maxConversations)))
+	d.Set("conversation_count", total.conversationCount)
+	d.Set("minutes", float64(total.callDurationSecs)/60)
+	d.Set("llm_cost", total.llmCost)
+	if err := d.Set("agents", agentList); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return diags
+}
+
+// usageConcurrentRequests bounds the conversations getConversations reads at
+// the same time.
+const usageConcurrentRequests = 4
+
+// getConversations reads the conversations of summaries, a few at a time. A
+// conversation that no longer exists is nil.
+func getConversations(ctx context.Context, client *Client, summaries []*ConversationSummary) ([]*Conversation, error) {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	conversations := make([]*Conversation, len(summaries))
+	errs := make([]error, len(summaries))
+	sem := make(chan struct{}, usageConcurrentRequests)
+	var wg sync.WaitGroup
+	for i, summary := range summaries {
+		sem <- struct{}{}
+		if ctx.Err() != nil {
+			break
+		}
+		wg.Add(1)
+		go func(i int, conversationID string) {
+			defer wg.Done()
+			defer func() { <-sem }()
+			conversations[i], errs[i] = client.GetConversation(ctx, conversationID)
+			if errs[i] != nil {
+				cancel()
+			}
+		}(i, summary.ConversationID)
+	}
+	wg.Wait()
+
+	// Report the error that stopped the reads rather than the cancellations
+	// it caused.
+	var canceled error
+	for _, err := range errs {
+		if err == nil {
+			continue
+		}
+		if !errors.Is(err, context.Canceled) {
+			return nil, err
+		}
+		canceled = err
+	}
+	if canceled != nil {
+		return nil, canceled
+	}
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	return conversations, nil
+}
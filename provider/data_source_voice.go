@@ -0,0 +1,81 @@
+package provider
+
+import (
+	"context"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceVoice() *schema.Resource {
+	s := voiceSchema()
+	s["voice_id"] = &schema.Schema{
+		Type:         schema.TypeString,
+		Optional:     true,
+		Computed:     true,
+		ExactlyOneOf: []string{"voice_id", "name"},
+	}
+	s["name"] = &schema.Schema{
+		Type:         schema.TypeString,
+		Optional:     true,
+		Computed:     true,
+		Description:  "The exact name of the voice, ignoring case. The lookup fails when no voice or more than one voice has this name.",
+		ExactlyOneOf: []string{"voice_id", "name"},
+	}
+
+	return &schema.Resource{
+		ReadContext: dataSourceVoiceRead,
+		Description: "Looks up a voice by ID or name.",
+		Schema:      s,
+	}
+}
+
+func dataSourceVoiceRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*Client)
+
+	var voice *Voice
+	if voiceID := d.Get("voice_id").(string); voiceID != "" {
+		var err error
+		voice, err = client.GetVoice(ctx, voiceID)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		if voice == nil {
+			return diag.Errorf("voice %q not found", voiceID)
+		}
+	} else {
+		name := d.Get("name").(string)
+		voices, err := client.ListVoices(ctx, name, "")
+		if err != nil {
+			return diag.FromErr(err)
+		}
+
+		var matches []*Voice
+		var matchIDs []string
+		for _, v := range voices {
+			if strings.EqualFold(v.Name, name) {
+				matches = append(matches, v)
+				matchIDs = append(matchIDs, v.VoiceID)
+			}
+		}
+		switch len(matches) {
+		case 0:
+			return diag.Errorf("no voice named %q found", name)
+		case 1:
+			voice = matches[0]
+		default:
+			return diag.Errorf("%d voices are named %q (%s), use voice_id to select one", len(matches), name, strings.Join(matchIDs, ", "))
+		}
+	}
+
+	d.SetId(voice.VoiceID)
+	d.Set("voice_id", voice.VoiceID)
+	d.Set("name", voice.Name)
+	d.Set("category", voice.Category)
+	d.Set("description", voice.Description)
+	d.Set("labels", voice.Labels)
+	d.Set("preview_url", voice.PreviewURL)
+
+	return nil
+}
@@ -0,0 +1,142 @@
+package provider
+
+import (
+	"context"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+var voiceCategories = []string{
+	"premade",
+	"cloned",
+	"generated",
+	"professional",
+	"famous",
+	"high_quality",
+}
+
+func dataSourceVoices() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceVoicesRead,
+		Description: "Lists the voices available to the workspace.",
+		Schema: map[string]*schema.Schema{
+			"search": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Only list voices whose name, description or labels match this text.",
+			},
+			"category": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringInSlice(voiceCategories, false),
+			},
+			"labels": {
+				Type:        schema.TypeMap,
+				Optional:    true,
+				Description: "Only list voices with all of these labels, such as `accent = \"british\"`, `gender = \"female\"` or `age = \"young\"`. Values are compared case-insensitively.",
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"voices": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Resource{Schema: voiceSchema()},
+			},
+			"ids": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+		},
+	}
+}
+
+func voiceSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"voice_id": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"name": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"category": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"description": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"labels": {
+			Type:     schema.TypeMap,
+			Computed: true,
+			Elem:     &schema.Schema{Type: schema.TypeString},
+		},
+		"preview_url": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+	}
+}
+
+func dataSourceVoicesRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*Client)
+	search := d.Get("search").(string)
+	category := d.Get("category").(string)
+
+	labels := make(map[string]string)
+	for key, val := range d.Get("labels").(map[string]interface{}) {
+		labels[key] = val.(string)
+	}
+
+	voices, err := client.ListVoices(ctx, search, category)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	var voiceList []interface{}
+	ids := []string{}
+	for _, voice := range voices {
+		if !hasAllLabels(voice.Labels, labels) {
+			continue
+		}
+		voiceList = append(voiceList, map[string]interface{}{
+			"voice_id":    voice.VoiceID,
+			"name":        voice.Name,
+			"category":    voice.Category,
+			"description": voice.Description,
+			"labels":      voice.Labels,
+			"preview_url": voice.PreviewURL,
+		})
+		ids = append(ids, voice.VoiceID)
+	}
+
+	idParts := []string{search, category}
+	for key, val := range labels {
+		idParts = append(idParts, key+"="+val)
+	}
+	sort.Strings(idParts[2:])
+	d.SetId(dataSourceID(idParts...))
+	if err := d.Set("voices", voiceList); err != nil {
+		return diag.FromErr(err)
+	}
+	d.Set("ids", ids)
+
+	return nil
+}
+
+// hasAllLabels reports whether labels contains every key of want with the
+// same value, ignoring case.
+func hasAllLabels(labels, want map[string]string) bool {
+	for key, val := range want {
+		if !strings.EqualFold(labels[key], val) {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,68 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type conversationTokenEphemeralResource struct {
+	client *Client
+}
+
+type conversationTokenModel struct {
+	AgentID types.String `tfsdk:"agent_id"`
+	Token   types.String `tfsdk:"token"`
+}
+
+var _ ephemeral.EphemeralResourceWithConfigure = &conversationTokenEphemeralResource{}
+
+func NewConversationTokenEphemeralResource() ephemeral.EphemeralResource {
+	return &conversationTokenEphemeralResource{}
+}
+
+func (r *conversationTokenEphemeralResource) Metadata(ctx context.Context, req ephemeral.MetadataRequest, resp *ephemeral.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_conversation_token"
+}
+
+func (r *conversationTokenEphemeralResource) Schema(ctx context.Context, req ephemeral.SchemaRequest, resp *ephemeral.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Gets a short-lived WebRTC token that starts a conversation with a private agent. The token is never stored in the state.",
+		Attributes: map[string]schema.Attribute{
+			"agent_id": schema.StringAttribute{
+				Required: true,
+			},
+			"token": schema.StringAttribute{
+				Computed:  true,
+				Sensitive: true,
+			},
+		},
+	}
+}
+
+func (r *conversationTokenEphemeralResource) Configure(ctx context.Context, req ephemeral.ConfigureRequest, resp *ephemeral.ConfigureResponse) {
+	r.client = ephemeralResourceClient(req, resp)
+}
+
+func (r *conversationTokenEphemeralResource) Open(ctx context.Context, req ephemeral.OpenRequest, resp *ephemeral.OpenResponse) {
+	var data conversationTokenModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if r.client == nil {
+		resp.Diagnostics.AddError("Provider not configured", "The conversation token cannot be fetched before the provider is configured.")
+		return
+	}
+
+	token, err := r.client.GetConversationToken(ctx, data.AgentID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to get conversation token", err.Error())
+		return
+	}
+
+	data.Token = types.StringValue(token)
+	resp.Diagnostics.Append(resp.Result.Set(ctx, &data)...)
+}
@@ -0,0 +1,68 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type signedURLEphemeralResource struct {
+	client *Client
+}
+
+type signedURLModel struct {
+	AgentID   types.String `tfsdk:"agent_id"`
+	SignedURL types.String `tfsdk:"signed_url"`
+}
+
+var _ ephemeral.EphemeralResourceWithConfigure = &signedURLEphemeralResource{}
+
+func NewSignedURLEphemeralResource() ephemeral.EphemeralResource {
+	return &signedURLEphemeralResource{}
+}
+
+func (r *signedURLEphemeralResource) Metadata(ctx context.Context, req ephemeral.MetadataRequest, resp *ephemeral.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_signed_url"
+}
+
+func (r *signedURLEphemeralResource) Schema(ctx context.Context, req ephemeral.SchemaRequest, resp *ephemeral.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Gets a short-lived signed WebSocket URL that starts a conversation with a private agent. The URL is never stored in the state.",
+		Attributes: map[string]schema.Attribute{
+			"agent_id": schema.StringAttribute{
+				Required: true,
+			},
+			"signed_url": schema.StringAttribute{
+				Computed:  true,
+				Sensitive: true,
+			},
+		},
+	}
+}
+
+func (r *signedURLEphemeralResource) Configure(ctx context.Context, req ephemeral.ConfigureRequest, resp *ephemeral.ConfigureResponse) {
+	r.client = ephemeralResourceClient(req, resp)
+}
+
+func (r *signedURLEphemeralResource) Open(ctx context.Context, req ephemeral.OpenRequest, resp *ephemeral.OpenResponse) {
+	var data signedURLModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if r.client == nil {
+		resp.Diagnostics.AddError("Provider not configured", "The signed URL cannot be fetched before the provider is configured.")
+		return
+	}
+
+	signedURL, err := r.client.GetSignedURL(ctx, data.AgentID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to get signed URL", err.Error())
+		return
+	}
+
+	data.SignedURL = types.StringValue(signedURL)
+	resp.Diagnostics.Append(resp.Result.Set(ctx, &data)...)
+}
@@ -0,0 +1,44 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type promptVariablesFunction struct{}
+
+var _ function.Function = &promptVariablesFunction{}
+
+func NewPromptVariablesFunction() function.Function {
+	return &promptVariablesFunction{}
+}
+
+func (f *promptVariablesFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "prompt_variables"
+}
+
+func (f *promptVariablesFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:     "Lists the dynamic variables used in a prompt",
+		Description: "Returns the names of the `{{variable}}` placeholders in a prompt or first message, in order of first use. System variables such as `{{system__time_utc}}` are left out, as ElevenLabs fills them in itself.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:        "template",
+				Description: "The prompt or first message.",
+			},
+		},
+		Return: function.ListReturn{ElementType: types.StringType},
+	}
+}
+
+func (f *promptVariablesFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var template string
+	resp.Error = req.Arguments.Get(ctx, &template)
+	if resp.Error != nil {
+		return
+	}
+
+	resp.Error = resp.Result.Set(ctx, promptVariables(template))
+}
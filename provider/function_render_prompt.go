@@ -0,0 +1,58 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type renderPromptFunction struct{}
+
+var _ function.Function = &renderPromptFunction{}
+
+func NewRenderPromptFunction() function.Function {
+	return &renderPromptFunction{}
+}
+
+func (f *renderPromptFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "render_prompt"
+}
+
+func (f *renderPromptFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:     "Fills in the dynamic variables of a prompt",
+		Description: "Replaces the `{{variable}}` placeholders in a prompt or first message with the given values, the way ElevenLabs does at the start of a conversation. It fails when a variable has no value. System variables such as `{{system__time_utc}}` are left in place.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:        "template",
+				Description: "The prompt or first message.",
+			},
+			function.MapParameter{
+				Name:        "variables",
+				Description: "The values of the dynamic variables, by name.",
+				ElementType: types.StringType,
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f *renderPromptFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var template string
+	var variables map[string]string
+	resp.Error = req.Arguments.Get(ctx, &template, &variables)
+	if resp.Error != nil {
+		return
+	}
+
+	rendered, missing := renderPrompt(template, variables)
+	if len(missing) > 0 {
+		resp.Error = function.NewArgumentFuncError(1, fmt.Sprintf("no value for dynamic variables: %s", strings.Join(missing, ", ")))
+		return
+	}
+
+	resp.Error = resp.Result.Set(ctx, rendered)
+}
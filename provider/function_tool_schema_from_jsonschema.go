@@ -0,0 +1,97 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type toolSchemaFromJSONSchemaFunction struct{}
+
+var _ function.Function = &toolSchemaFromJSONSchemaFunction{}
+
+func NewToolSchemaFromJSONSchemaFunction() function.Function {
+	return &toolSchemaFromJSONSchemaFunction{}
+}
+
+// toolSchemaResult is the object returned by tool_schema_from_jsonschema.
+type toolSchemaResult struct {
+	RequestBodySchema string                 `tfsdk:"request_body_schema"`
+	QueryParamsSchema *toolQueryParamsResult `tfsdk:"query_params_schema"`
+}
+
+// toolQueryParamsResult is shaped like the query_params_schema block of
+// elevenlabs_tool.
+type toolQueryParamsResult struct {
+	Properties []toolQueryParam `tfsdk:"properties"`
+	Required   []string         `tfsdk:"required"`
+}
+
+func (f *toolSchemaFromJSONSchemaFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "tool_schema_from_jsonschema"
+}
+
+func (f *toolSchemaFromJSONSchemaFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:     "Converts a JSON Schema document into tool parameter schemas",
+		Description: "Checks that a JSON Schema document only uses the subset ElevenLabs supports in tool parameters (`type`, `description`, `enum`, `properties`, `required` and `items`) and returns it in the normalized forms expected by `elevenlabs_tool`. The root schema must be an object. The `$schema` and `title` annotations are dropped; any other keyword, such as `$ref` or `oneOf`, is an error.\n\nThe result has two attributes. `request_body_schema` is the normalized JSON for `api_schema.request_body_schema`. `query_params_schema` holds the `properties` (a list of `name`, `type` and `description`, sorted by name) and `required` arguments of `api_schema.query_params_schema`, for use in `dynamic` blocks; it is null when a property is not a string, number, integer or boolean, or has an `enum`, as query parameters cannot express these.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:        "document",
+				Description: "The JSON Schema document, for example from `file()` or `jsonencode()`.",
+			},
+		},
+		Return: function.ObjectReturn{
+			AttributeTypes: map[string]attr.Type{
+				"request_body_schema": types.StringType,
+				"query_params_schema": types.ObjectType{
+					AttrTypes: map[string]attr.Type{
+						"properties": types.ListType{
+							ElemType: types.ObjectType{
+								AttrTypes: map[string]attr.Type{
+									"name":        types.StringType,
+									"type":        types.StringType,
+									"description": types.StringType,
+								},
+							},
+						},
+						"required": types.ListType{ElemType: types.StringType},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (f *toolSchemaFromJSONSchemaFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var document string
+	resp.Error = req.Arguments.Get(ctx, &document)
+	if resp.Error != nil {
+		return
+	}
+
+	schema, err := parseToolJSONSchema(document)
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(0, err.Error())
+		return
+	}
+	encoded, err := encodeToolJSONSchema(schema)
+	if err != nil {
+		resp.Error = function.NewFuncError(err.Error())
+		return
+	}
+
+	result := toolSchemaResult{RequestBodySchema: encoded}
+	// A schema that cannot be expressed as query parameters is still a
+	// valid request body, so only the query parameters are left out.
+	if properties, required, err := toolQueryParams(schema); err == nil {
+		result.QueryParamsSchema = &toolQueryParamsResult{
+			Properties: properties,
+			Required:   required,
+		}
+	}
+
+	resp.Error = resp.Result.Set(ctx, result)
+}
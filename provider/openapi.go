@@ -0,0 +1,497 @@
+package provider
+
+import (
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+// openAPIMethods are the operations of an OpenAPI path item that can become
+// webhook tools.
+var openAPIMethods = []string{"get", "put", "post", "delete", "patch"}
+
+// openAPISchemaAnnotations are the schema keywords that only constrain or
+// describe values. Tool schemas cannot express them, so they are dropped
+// before a schema is checked against the supported subset.
+var openAPISchemaAnnotations = map[string]bool{
+	"title":            true,
+	"format":           true,
+	"example":          true,
+	"examples":         true,
+	"default":          true,
+	"nullable":         true,
+	"readOnly":         true,
+	"writeOnly":        true,
+	"deprecated":       true,
+	"minimum":          true,
+	"maximum":          true,
+	"exclusiveMinimum": true,
+	"exclusiveMaximum": true,
+	"multipleOf":       true,
+	"minLength":        true,
+	"maxLength":        true,
+	"pattern":          true,
+	"minItems":         true,
+	"maxItems":         true,
+	"uniqueItems":      true,
+	"minProperties":    true,
+	"maxProperties":    true,
+	"xml":              true,
+	"externalDocs":     true,
+}
+
+var toolNameInvalidChars = regexp.MustCompile(`[^a-zA-Z0-9_-]`)
+
+// OpenAPIOperation is an operation of an OpenAPI document converted to the
+// API schema of a webhook tool.
+type OpenAPIOperation struct {
+	OperationID string
+	Name        string
+	Description string
+	Tags        []string
+	APISchema   *APISchema
+	// Warnings lists what the API schema leaves out, such as required
+	// header parameters.
+	Warnings []string
+}
+
+// parseOpenAPIDocument decodes an OpenAPI 3 document in JSON or YAML. Numbers
+// are kept as json.Number, as the tool schema normalizer expects.
+func parseOpenAPIDocument(content []byte) (map[string]interface{}, error) {
+	var raw interface{}
+	if err := yaml.Unmarshal(content, &raw); err != nil {
+		return nil, fmt.Errorf("invalid OpenAPI document: %s", err)
+	}
+	encoded, err := json.Marshal(stringifyYAMLKeys(raw))
+	if err != nil {
+		return nil, fmt.Errorf("invalid OpenAPI document: %s", err)
+	}
+
+	decoder := json.NewDecoder(strings.NewReader(string(encoded)))
+	decoder.UseNumber()
+	var doc map[string]interface{}
+	if err := decoder.Decode(&doc); err != nil {
+		return nil, fmt.Errorf("invalid OpenAPI document: %s", err)
+	}
+
+	version, _ := doc["openapi"].(string)
+	if !strings.HasPrefix(version, "3.") {
+		return nil, fmt.Errorf("unsupported OpenAPI document: expected openapi version 3.x, got %q", version)
+	}
+	return doc, nil
+}
+
+// stringifyYAMLKeys converts the maps decoded by yaml.v3 with non-string
+// keys, such as response codes, into maps with string keys.
+func stringifyYAMLKeys(v interface{}) interface{} {
+	switch v := v.(type) {
+	case map[string]interface{}:
+		for key, val := range v {
+			v[key] = stringifyYAMLKeys(val)
+		}
+		return v
+	case map[interface{}]interface{}:
+		m := make(map[string]interface{}, len(v))
+		for key, val := range v {
+			m[fmt.Sprint(key)] = stringifyYAMLKeys(val)
+		}
+		return m
+	case []interface{}:
+		for i, val := range v {
+			v[i] = stringifyYAMLKeys(val)
+		}
+		return v
+	}
+	return v
+}
+
+// openAPIServerURL returns the URL of the first server of the document, with
+// its variables set to their defaults.
+func openAPIServerURL(doc map[string]interface{}) string {
+	servers, _ := doc["servers"].([]interface{})
+	if len(servers) == 0 {
+		return ""
+	}
+	server, _ := servers[0].(map[string]interface{})
+	serverURL, _ := server["url"].(string)
+	variables, _ := server["variables"].(map[string]interface{})
+	for name, v := range variables {
+		variable, _ := v.(map[string]interface{})
+		if def, ok := variable["default"].(string); ok {
+			serverURL = strings.ReplaceAll(serverURL, "{"+name+"}", def)
+		}
+	}
+	return serverURL
+}
+
+// OpenAPIOperationFilter selects operations by tag or operationId. An
+// operation is selected when it matches an include, or when no includes are
+// set, and matches no exclude.
+type OpenAPIOperationFilter struct {
+	IncludeTags         []string
+	ExcludeTags         []string
+	IncludeOperationIDs []string
+	ExcludeOperationIDs []string
+}
+
+func (f *OpenAPIOperationFilter) matches(operationID string, tags []string) bool {
+	matchesAny := func(values []string, candidates ...string) bool {
+		for _, v := range values {
+			for _, c := range candidates {
+				if v == c {
+					return true
+				}
+			}
+		}
+		return false
+	}
+
+	if matchesAny(f.ExcludeOperationIDs, operationID) || matchesAny(f.ExcludeTags, tags...) {
+		return false
+	}
+	if len(f.IncludeTags) == 0 && len(f.IncludeOperationIDs) == 0 {
+		return true
+	}
+	return matchesAny(f.IncludeOperationIDs, operationID) || matchesAny(f.IncludeTags, tags...)
+}
+
+// openAPIOperations converts the operations of doc selected by filter into
+// webhook tool API schemas, sorted by path and method. Selected operations
+// whose parameters or request body cannot be expressed as a tool schema, or
+// that would get the same tool name, are reported as errors.
+func openAPIOperations(doc map[string]interface{}, baseURL string, filter *OpenAPIOperationFilter) ([]*OpenAPIOperation, error) {
+	paths, _ := doc["paths"].(map[string]interface{})
+	pathNames := make([]string, 0, len(paths))
+	for path := range paths {
+		pathNames = append(pathNames, path)
+	}
+	sort.Strings(pathNames)
+
+	var operations []*OpenAPIOperation
+	operationsByName := make(map[string]string)
+	for _, path := range pathNames {
+		pathItem, ok := paths[path].(map[string]interface{})
+		if !ok {
+			continue
+		}
+		for _, method := range openAPIMethods {
+			op, ok := pathItem[method].(map[string]interface{})
+			if !ok {
+				continue
+			}
+			operationID, _ := op["operationId"].(string)
+			if !filter.matches(operationID, openAPIOperationTags(op)) {
+				continue
+			}
+			operation, err := openAPIOperation(doc, baseURL, path, method, pathItem, op)
+			if err != nil {
+				return nil, fmt.Errorf("%s %s: %s", strings.ToUpper(method), path, err)
+			}
+			where := strings.ToUpper(method) + " " + path
+			if other, ok := operationsByName[operation.Name]; ok {
+				return nil, fmt.Errorf("%s and %s both become the tool %q, give them distinct operationIds or exclude one", other, where, operation.Name)
+			}
+			operationsByName[operation.Name] = where
+			operations = append(operations, operation)
+		}
+	}
+	return operations, nil
+}
+
+func openAPIOperation(doc map[string]interface{}, baseURL, path, method string, pathItem, op map[string]interface{}) (*OpenAPIOperation, error) {
+	operationID, _ := op["operationId"].(string)
+	name := operationID
+	if name == "" {
+		name = method + "_" + strings.Trim(path, "/")
+	}
+	name = toolNameInvalidChars.ReplaceAllString(name, "_")
+
+	description, _ := op["summary"].(string)
+	if description == "" {
+		description, _ = op["description"].(string)
+	}
+
+	apiSchema := &APISchema{
+		URL:    strings.TrimSuffix(baseURL, "/") + path,
+		Method: strings.ToUpper(method),
+	}
+
+	parameters, err := openAPIParameters(doc, pathItem, op)
+	if err != nil {
+		return nil, err
+	}
+	var warnings []string
+	for _, param := range parameters {
+		in, _ := param["in"].(string)
+		paramName, _ := param["name"].(string)
+		if in != "path" && in != "query" {
+			// Tools only fill in path and query parameters. Optional
+			// header and cookie parameters can be left out, but a tool
+			// without a required one fails when it is called.
+			if required, _ := param["required"].(bool); required {
+				warnings = append(warnings, fmt.Sprintf("required %s parameter %q is not part of the tool, set it in api_schema.request_headers", in, paramName))
+			}
+			continue
+		}
+		property, err := openAPIParameterProperty(param)
+		if err != nil {
+			return nil, fmt.Errorf("parameter %q: %s", paramName, err)
+		}
+		if in == "path" {
+			if apiSchema.PathParamsSchema == nil {
+				apiSchema.PathParamsSchema = make(map[string]LiteralJsonSchemaProperty)
+			}
+			apiSchema.PathParamsSchema[paramName] = property
+			continue
+		}
+		if apiSchema.QueryParamsSchema == nil {
+			apiSchema.QueryParamsSchema = &QueryParamsJsonSchema{
+				Properties: make(map[string]LiteralJsonSchemaProperty),
+			}
+		}
+		apiSchema.QueryParamsSchema.Properties[paramName] = property
+		if required, _ := param["required"].(bool); required {
+			apiSchema.QueryParamsSchema.Required = append(apiSchema.QueryParamsSchema.Required, paramName)
+		}
+	}
+	if apiSchema.QueryParamsSchema != nil {
+		sort.Strings(apiSchema.QueryParamsSchema.Required)
+	}
+
+	if requestBody, ok := op["requestBody"]; ok {
+		body, err := openAPIRequestBodySchema(doc, requestBody)
+		if err != nil {
+			return nil, fmt.Errorf("request body: %s", err)
+		}
+		apiSchema.RequestBodySchema = body
+	}
+
+	return &OpenAPIOperation{
+		OperationID: operationID,
+		Name:        name,
+		Description: description,
+		Tags:        openAPIOperationTags(op),
+		APISchema:   apiSchema,
+		Warnings:    warnings,
+	}, nil
+}
+
+func openAPIOperationTags(op map[string]interface{}) []string {
+	var tags []string
+	if tagList, ok := op["tags"].([]interface{}); ok {
+		for _, tag := range tagList {
+			if s, ok := tag.(string); ok {
+				tags = append(tags, s)
+			}
+		}
+	}
+	return tags
+}
+
+// openAPIParameters returns the parameters of an operation, including those
+// declared on its path item unless the operation overrides them.
+func openAPIParameters(doc, pathItem, op map[string]interface{}) ([]map[string]interface{}, error) {
+	var parameters []map[string]interface{}
+	index := make(map[string]int)
+	for _, source := range []interface{}{pathItem["parameters"], op["parameters"]} {
+		list, _ := source.([]interface{})
+		for _, p := range list {
+			resolved, err := resolveOpenAPIRefs(doc, p, nil)
+			if err != nil {
+				return nil, err
+			}
+			param, ok := resolved.(map[string]interface{})
+			if !ok {
+				return nil, fmt.Errorf("parameters must be objects")
+			}
+			key := fmt.Sprintf("%v/%v", param["in"], param["name"])
+			if i, ok := index[key]; ok {
+				parameters[i] = param
+				continue
+			}
+			index[key] = len(parameters)
+			parameters = append(parameters, param)
+		}
+	}
+	return parameters, nil
+}
+
+// openAPIParameterProperty returns the tool property of a path or query
+// parameter. Tool parameters only have a type and a description, so
+// constraints such as enum are dropped.
+func openAPIParameterProperty(param map[string]interface{}) (LiteralJsonSchemaProperty, error) {
+	schema, _ := param["schema"].(map[string]interface{})
+	schemaType := openAPISchemaType(schema["type"])
+	switch schemaType {
+	case "string", "number", "integer", "boolean":
+	case "":
+		return LiteralJsonSchemaProperty{}, fmt.Errorf("schema type must be set")
+	default:
+		return LiteralJsonSchemaProperty{}, fmt.Errorf("unsupported type %q, tool parameters must be strings, numbers, integers or booleans", schemaType)
+	}
+
+	description, _ := param["description"].(string)
+	if description == "" {
+		description, _ = schema["description"].(string)
+	}
+	return LiteralJsonSchemaProperty{Type: schemaType, Description: description}, nil
+}
+
+// openAPIRequestBodySchema returns the normalized JSON schema of the
+// application/json content of a request body.
+func openAPIRequestBodySchema(doc map[string]interface{}, requestBody interface{}) (json.RawMessage, error) {
+	resolved, err := resolveOpenAPIRefs(doc, requestBody, nil)
+	if err != nil {
+		return nil, err
+	}
+	body, _ := resolved.(map[string]interface{})
+	content, _ := body["content"].(map[string]interface{})
+	media, ok := content["application/json"].(map[string]interface{})
+	if !ok {
+		return nil, fmt.Errorf("only application/json request bodies are supported")
+	}
+
+	schema := stripOpenAPIAnnotations(media["schema"])
+	if schemaMap, ok := schema.(map[string]interface{}); ok {
+		if _, ok := schemaMap["description"]; !ok {
+			if description, ok := body["description"].(string); ok {
+				schemaMap["description"] = description
+			}
+		}
+	}
+
+	normalized, err := normalizeToolJSONSchema(schema, "", false)
+	if err != nil {
+		return nil, err
+	}
+	if normalized["type"] != "object" {
+		return nil, fmt.Errorf("the schema must have type \"object\", got %q", normalized["type"])
+	}
+	encoded, err := encodeToolJSONSchema(normalized)
+	if err != nil {
+		return nil, err
+	}
+	return json.RawMessage(encoded), nil
+}
+
+// resolveOpenAPIRefs replaces every local $ref in v with the value it points
+// to. stack holds the references being resolved, to detect cycles.
+func resolveOpenAPIRefs(doc map[string]interface{}, v interface{}, stack []string) (interface{}, error) {
+	switch v := v.(type) {
+	case map[string]interface{}:
+		if ref, ok := v["$ref"].(string); ok {
+			for _, r := range stack {
+				if r == ref {
+					return nil, fmt.Errorf("recursive reference %s is not supported", ref)
+				}
+			}
+			target, err := lookupOpenAPIRef(doc, ref)
+			if err != nil {
+				return nil, err
+			}
+			return resolveOpenAPIRefs(doc, target, append(stack, ref))
+		}
+		resolved := make(map[string]interface{}, len(v))
+		for key, val := range v {
+			r, err := resolveOpenAPIRefs(doc, val, stack)
+			if err != nil {
+				return nil, err
+			}
+			resolved[key] = r
+		}
+		return resolved, nil
+	case []interface{}:
+		resolved := make([]interface{}, len(v))
+		for i, val := range v {
+			r, err := resolveOpenAPIRefs(doc, val, stack)
+			if err != nil {
+				return nil, err
+			}
+			resolved[i] = r
+		}
+		return resolved, nil
+	}
+	return v, nil
+}
+
+// lookupOpenAPIRef returns the value of a local reference such as
+// #/components/schemas/Pet.
+func lookupOpenAPIRef(doc map[string]interface{}, ref string) (interface{}, error) {
+	if !strings.HasPrefix(ref, "#/") {
+		return nil, fmt.Errorf("reference %s is not supported, only references within the document are", ref)
+	}
+	var current interface{} = doc
+	for _, token := range strings.Split(strings.TrimPrefix(ref, "#/"), "/") {
+		token = strings.ReplaceAll(strings.ReplaceAll(token, "~1", "/"), "~0", "~")
+		m, ok := current.(map[string]interface{})
+		if !ok {
+			return nil, fmt.Errorf("reference %s not found", ref)
+		}
+		if current, ok = m[token]; !ok {
+			return nil, fmt.Errorf("reference %s not found", ref)
+		}
+	}
+	return current, nil
+}
+
+// stripOpenAPIAnnotations removes the keywords in openAPISchemaAnnotations
+// and extensions from a resolved schema, and reduces OpenAPI 3.1 nullable
+// types such as ["string", "null"] to their single type.
+func stripOpenAPIAnnotations(v interface{}) interface{} {
+	schema, ok := v.(map[string]interface{})
+	if !ok {
+		return v
+	}
+	stripped := make(map[string]interface{}, len(schema))
+	for key, val := range schema {
+		if openAPISchemaAnnotations[key] || strings.HasPrefix(key, "x-") {
+			continue
+		}
+		switch key {
+		case "type":
+			if schemaType := openAPISchemaType(val); schemaType != "" {
+				val = schemaType
+			}
+		case "properties":
+			if properties, ok := val.(map[string]interface{}); ok {
+				strippedProperties := make(map[string]interface{}, len(properties))
+				for name, property := range properties {
+					strippedProperties[name] = stripOpenAPIAnnotations(property)
+				}
+				val = strippedProperties
+			}
+		case "items":
+			val = stripOpenAPIAnnotations(val)
+		}
+		stripped[key] = val
+	}
+	return stripped
+}
+
+// openAPISchemaType returns the type of a schema, accepting the OpenAPI 3.1
+// form that lists "null" next to the type. It returns "" when the type is
+// missing or lists several non-null types.
+func openAPISchemaType(v interface{}) string {
+	switch v := v.(type) {
+	case string:
+		return v
+	case []interface{}:
+		schemaType := ""
+		for _, t := range v {
+			s, _ := t.(string)
+			if s == "null" {
+				continue
+			}
+			if schemaType != "" {
+				return ""
+			}
+			schemaType = s
+		}
+		return schemaType
+	}
+	return ""
+}
@@ -0,0 +1,422 @@
+package provider
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+)
+
+const openAPITestDocument = `
+openapi: 3.1.0
+servers:
+  - url: https://{region}.example.com/v1/
+    variables:
+      region:
+        default: eu
+paths:
+  /orders/{order_id}:
+    parameters:
+      - $ref: '#/components/parameters/OrderID'
+      - name: verbose
+        in: query
+        schema:
+          type: boolean
+    get:
+      operationId: getOrder
+      summary: Get an order.
+      tags: [orders]
+      parameters:
+        - name: verbose
+          in: query
+          description: Include the order lines.
+          schema:
+            type: boolean
+        - name: X-Tenant
+          in: header
+          required: true
+          schema:
+            type: string
+        - name: session
+          in: cookie
+          schema:
+            type: string
+    delete:
+      operationId: deleteOrder
+      description: Delete an order.
+      tags: [orders, admin]
+  /orders:
+    post:
+      operationId: create.order
+      tags: [orders]
+      requestBody:
+        description: The order to create.
+        content:
+          application/json:
+            schema:
+              $ref: '#/components/schemas/Order'
+  /health:
+    get:
+      tags: [internal]
+components:
+  parameters:
+    OrderID:
+      name: order_id
+      in: path
+      required: true
+      schema:
+        type: string
+        description: The order ID.
+  schemas:
+    Order:
+      type: object
+      required: [sku]
+      x-internal: true
+      properties:
+        sku:
+          type: string
+          example: ABC-1
+          maxLength: 10
+        quantity:
+          type: [integer, "null"]
+          minimum: 1
+`
+
+func parseOpenAPITestDocument(t *testing.T, content string) map[string]interface{} {
+	t.Helper()
+	doc, err := parseOpenAPIDocument([]byte(content))
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	return doc
+}
+
+func TestParseOpenAPIDocument(t *testing.T) {
+	cases := []struct {
+		name    string
+		content string
+		wantErr string
+	}{
+		{
+			name:    "yaml",
+			content: "openapi: 3.0.3\npaths: {}\n",
+		},
+		{
+			name:    "json",
+			content: `{"openapi": "3.1.0", "paths": {}}`,
+		},
+		{
+			name:    "swagger 2",
+			content: `{"swagger": "2.0", "paths": {}}`,
+			wantErr: `expected openapi version 3.x, got ""`,
+		},
+		{
+			name:    "invalid",
+			content: "openapi: [3",
+			wantErr: "invalid OpenAPI document",
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := parseOpenAPIDocument([]byte(tc.content))
+			if tc.wantErr == "" {
+				if err != nil {
+					t.Fatalf("unexpected error: %s", err)
+				}
+				return
+			}
+			if err == nil || !strings.Contains(err.Error(), tc.wantErr) {
+				t.Fatalf("expected error containing %q, got %v", tc.wantErr, err)
+			}
+		})
+	}
+}
+
+func TestOpenAPIServerURL(t *testing.T) {
+	doc := parseOpenAPITestDocument(t, openAPITestDocument)
+	if got, want := openAPIServerURL(doc), "https://eu.example.com/v1/"; got != want {
+		t.Fatalf("expected %q, got %q", want, got)
+	}
+}
+
+func TestResolveOpenAPIRefs(t *testing.T) {
+	doc := parseOpenAPITestDocument(t, `
+openapi: 3.0.3
+components:
+  schemas:
+    Name:
+      type: string
+    Person:
+      type: object
+      properties:
+        name:
+          $ref: '#/components/schemas/Name'
+    Node:
+      type: object
+      properties:
+        next:
+          $ref: '#/components/schemas/Node'
+    a/b:
+      type: boolean
+`)
+
+	cases := []struct {
+		name    string
+		ref     string
+		want    interface{}
+		wantErr string
+	}{
+		{
+			name: "nested",
+			ref:  "#/components/schemas/Person",
+			want: map[string]interface{}{
+				"type": "object",
+				"properties": map[string]interface{}{
+					"name": map[string]interface{}{"type": "string"},
+				},
+			},
+		},
+		{
+			name: "escaped token",
+			ref:  "#/components/schemas/a~1b",
+			want: map[string]interface{}{"type": "boolean"},
+		},
+		{
+			name:    "recursive",
+			ref:     "#/components/schemas/Node",
+			wantErr: "recursive reference #/components/schemas/Node is not supported",
+		},
+		{
+			name:    "missing",
+			ref:     "#/components/schemas/Pet",
+			wantErr: "reference #/components/schemas/Pet not found",
+		},
+		{
+			name:    "external",
+			ref:     "common.yaml#/components/schemas/Name",
+			wantErr: "only references within the document are",
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			got, err := resolveOpenAPIRefs(doc, map[string]interface{}{"$ref": tc.ref}, nil)
+			if tc.wantErr != "" {
+				if err == nil || !strings.Contains(err.Error(), tc.wantErr) {
+					t.Fatalf("expected error containing %q, got %v", tc.wantErr, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+			if !reflect.DeepEqual(got, tc.want) {
+				t.Fatalf("expected %#v, got %#v", tc.want, got)
+			}
+		})
+	}
+}
+
+func TestOpenAPIOperations(t *testing.T) {
+	doc := parseOpenAPITestDocument(t, openAPITestDocument)
+
+	operations, err := openAPIOperations(doc, "https://api.example.com/", &OpenAPIOperationFilter{})
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	var names []string
+	byName := make(map[string]*OpenAPIOperation)
+	for _, operation := range operations {
+		names = append(names, operation.Name)
+		byName[operation.Name] = operation
+	}
+	if want := []string{"get_health", "create_order", "getOrder", "deleteOrder"}; !reflect.DeepEqual(names, want) {
+		t.Fatalf("expected operations %v, got %v", want, names)
+	}
+
+	getOrder := byName["getOrder"]
+	if getOrder.Description != "Get an order." {
+		t.Errorf("unexpected description %q", getOrder.Description)
+	}
+	if getOrder.APISchema.URL != "https://api.example.com/orders/{order_id}" || getOrder.APISchema.Method != "GET" {
+		t.Errorf("unexpected request %s %s", getOrder.APISchema.Method, getOrder.APISchema.URL)
+	}
+	wantPathParams := map[string]LiteralJsonSchemaProperty{
+		"order_id": {Type: "string", Description: "The order ID."},
+	}
+	if !reflect.DeepEqual(getOrder.APISchema.PathParamsSchema, wantPathParams) {
+		t.Errorf("expected path parameters %#v, got %#v", wantPathParams, getOrder.APISchema.PathParamsSchema)
+	}
+	wantQueryParams := &QueryParamsJsonSchema{
+		Properties: map[string]LiteralJsonSchemaProperty{
+			"verbose": {Type: "boolean", Description: "Include the order lines."},
+		},
+	}
+	if !reflect.DeepEqual(getOrder.APISchema.QueryParamsSchema, wantQueryParams) {
+		t.Errorf("expected query parameters %#v, got %#v", wantQueryParams, getOrder.APISchema.QueryParamsSchema)
+	}
+
+	wantWarnings := []string{`required header parameter "X-Tenant" is not part of the tool, set it in api_schema.request_headers`}
+	if !reflect.DeepEqual(getOrder.Warnings, wantWarnings) {
+		t.Errorf("expected warnings %#v, got %#v", wantWarnings, getOrder.Warnings)
+	}
+
+	if got := byName["deleteOrder"].Description; got != "Delete an order." {
+		t.Errorf("expected the description to fall back to the operation description, got %q", got)
+	}
+
+	createOrder := byName["create_order"]
+	if createOrder.OperationID != "create.order" {
+		t.Errorf("unexpected operationId %q", createOrder.OperationID)
+	}
+	wantBody := `{"description":"The order to create.","properties":{"quantity":{"type":"integer"},"sku":{"type":"string"}},"required":["sku"],"type":"object"}`
+	if got := string(createOrder.APISchema.RequestBodySchema); got != wantBody {
+		t.Errorf("expected request body %s, got %s", wantBody, got)
+	}
+}
+
+func TestOpenAPIOperationsFilter(t *testing.T) {
+	doc := parseOpenAPITestDocument(t, openAPITestDocument)
+
+	cases := []struct {
+		name   string
+		filter *OpenAPIOperationFilter
+		want   []string
+	}{
+		{
+			name:   "include tags",
+			filter: &OpenAPIOperationFilter{IncludeTags: []string{"internal"}},
+			want:   []string{"get_health"},
+		},
+		{
+			name:   "include tags and operation ids",
+			filter: &OpenAPIOperationFilter{IncludeTags: []string{"internal"}, IncludeOperationIDs: []string{"getOrder"}},
+			want:   []string{"get_health", "getOrder"},
+		},
+		{
+			name:   "exclude tags",
+			filter: &OpenAPIOperationFilter{IncludeTags: []string{"orders"}, ExcludeTags: []string{"admin"}},
+			want:   []string{"create_order", "getOrder"},
+		},
+		{
+			name:   "exclude operation ids",
+			filter: &OpenAPIOperationFilter{ExcludeOperationIDs: []string{"getOrder", "deleteOrder"}},
+			want:   []string{"get_health", "create_order"},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			operations, err := openAPIOperations(doc, "https://api.example.com", tc.filter)
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+			var names []string
+			for _, operation := range operations {
+				names = append(names, operation.Name)
+			}
+			if !reflect.DeepEqual(names, tc.want) {
+				t.Fatalf("expected operations %v, got %v", tc.want, names)
+			}
+		})
+	}
+}
+
+func TestOpenAPIOperationsErrors(t *testing.T) {
+	cases := []struct {
+		name    string
+		paths   string
+		wantErr string
+	}{
+		{
+			name: "duplicate tool names",
+			paths: `
+  /a:
+    get:
+      operationId: list.items
+  /b:
+    get:
+      operationId: list_items
+`,
+			wantErr: `GET /a and GET /b both become the tool "list_items"`,
+		},
+		{
+			name: "object parameter",
+			paths: `
+  /items:
+    get:
+      parameters:
+        - name: filter
+          in: query
+          schema:
+            type: object
+`,
+			wantErr: `GET /items: parameter "filter": unsupported type "object"`,
+		},
+		{
+			name: "parameter without type",
+			paths: `
+  /items:
+    get:
+      parameters:
+        - name: q
+          in: query
+          schema: {}
+`,
+			wantErr: `parameter "q": schema type must be set`,
+		},
+		{
+			name: "form body",
+			paths: `
+  /items:
+    post:
+      requestBody:
+        content:
+          application/x-www-form-urlencoded:
+            schema:
+              type: object
+`,
+			wantErr: "POST /items: request body: only application/json request bodies are supported",
+		},
+		{
+			name: "array body",
+			paths: `
+  /items:
+    post:
+      requestBody:
+        content:
+          application/json:
+            schema:
+              type: array
+              items:
+                type: string
+`,
+			wantErr: `request body: the schema must have type "object", got "array"`,
+		},
+		{
+			name: "body with unsupported keyword",
+			paths: `
+  /items:
+    post:
+      requestBody:
+        content:
+          application/json:
+            schema:
+              type: object
+              oneOf: []
+`,
+			wantErr: `request body: the root schema: unsupported keyword "oneOf"`,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			doc := parseOpenAPITestDocument(t, "openapi: 3.0.3\npaths:"+tc.paths)
+			_, err := openAPIOperations(doc, "https://api.example.com", &OpenAPIOperationFilter{})
+			if err == nil || !strings.Contains(err.Error(), tc.wantErr) {
+				t.Fatalf("expected error containing %q, got %v", tc.wantErr, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,55 @@
+package provider
+
+import (
+	"regexp"
+	"strings"
+)
+
+// promptVariablePattern matches the dynamic variable placeholders ElevenLabs
+// substitutes in an agent's prompt and first message, such as {{user_name}}.
+var promptVariablePattern = regexp.MustCompile(`\{\{\s*([a-zA-Z0-9_]+)\s*\}\}`)
+
+// systemVariablePrefix marks the dynamic variables ElevenLabs fills in itself,
+// such as system__time_utc. They cannot be supplied by the caller.
+const systemVariablePrefix = "system__"
+
+// promptVariables returns the names of the dynamic variables used in
+// template, in order of first use, leaving out system variables.
+func promptVariables(template string) []string {
+	names := []string{}
+	seen := make(map[string]bool)
+	for _, match := range promptVariablePattern.FindAllStringSubmatch(template, -1) {
+		name := match[1]
+		if seen[name] || strings.HasPrefix(name, systemVariablePrefix) {
+			continue
+		}
+		seen[name] = true
+		names = append(names, name)
+	}
+	return names
+}
+
+// renderPrompt substitutes the dynamic variables in template with their
+// values. System variables are left in place, as ElevenLabs fills them in
+// during the conversation. It returns the names of the variables that have
+// no value, in order of first use.
+func renderPrompt(template string, values map[string]string) (string, []string) {
+	var missing []string
+	seen := make(map[string]bool)
+	rendered := promptVariablePattern.ReplaceAllStringFunc(template, func(placeholder string) string {
+		name := promptVariablePattern.FindStringSubmatch(placeholder)[1]
+		if strings.HasPrefix(name, systemVariablePrefix) {
+			return placeholder
+		}
+		value, ok := values[name]
+		if !ok {
+			if !seen[name] {
+				seen[name] = true
+				missing = append(missing, name)
+			}
+			return placeholder
+		}
+		return value
+	})
+	return rendered, missing
+}
@@ -0,0 +1,97 @@
+package provider
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestPromptVariables(t *testing.T) {
+	cases := []struct {
+		name     string
+		template string
+		want     []string
+	}{
+		{
+			name:     "no variables",
+			template: "You are a helpful assistant.",
+			want:     []string{},
+		},
+		{
+			name:     "order of first use",
+			template: "Hello {{user_name}}, your order {{order_id}} is ready, {{user_name}}.",
+			want:     []string{"user_name", "order_id"},
+		},
+		{
+			name:     "whitespace inside the braces",
+			template: "Hello {{ user_name }}.",
+			want:     []string{"user_name"},
+		},
+		{
+			name:     "system variables left out",
+			template: "It is {{system__time_utc}}, {{user_name}}.",
+			want:     []string{"user_name"},
+		},
+		{
+			name:     "not a placeholder",
+			template: "Use {single} braces or {{two words}}.",
+			want:     []string{},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			got := promptVariables(tc.template)
+			if !reflect.DeepEqual(got, tc.want) {
+				t.Fatalf("expected %#v, got %#v", tc.want, got)
+			}
+		})
+	}
+}
+
+func TestRenderPrompt(t *testing.T) {
+	cases := []struct {
+		name        string
+		template    string
+		values      map[string]string
+		want        string
+		wantMissing []string
+	}{
+		{
+			name:     "all values",
+			template: "Hello {{user_name}}, order {{ order_id }}.",
+			values:   map[string]string{"user_name": "Ada", "order_id": "42"},
+			want:     "Hello Ada, order 42.",
+		},
+		{
+			name:        "missing values kept",
+			template:    "Hello {{user_name}}, order {{order_id}}, bye {{user_name}}.",
+			values:      map[string]string{"order_id": "42"},
+			want:        "Hello {{user_name}}, order 42, bye {{user_name}}.",
+			wantMissing: []string{"user_name"},
+		},
+		{
+			name:     "system variables kept",
+			template: "It is {{system__time_utc}}.",
+			values:   map[string]string{"system__time_utc": "noon"},
+			want:     "It is {{system__time_utc}}.",
+		},
+		{
+			name:     "empty value",
+			template: "[{{suffix}}]",
+			values:   map[string]string{"suffix": ""},
+			want:     "[]",
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			got, missing := renderPrompt(tc.template, tc.values)
+			if got != tc.want {
+				t.Errorf("expected %q, got %q", tc.want, got)
+			}
+			if !reflect.DeepEqual(missing, tc.wantMissing) {
+				t.Errorf("expected missing %#v, got %#v", tc.wantMissing, missing)
+			}
+		})
+	}
+}
@@ -12,17 +12,43 @@ func Provider() *schema.Provider {
 		Schema: map[string]*schema.Schema{
 			"api_key": {
 				Type:        schema.TypeString,
-				Required:    true,
+				Optional:    true,
 				Sensitive:   true,
 				DefaultFunc: schema.EnvDefaultFunc("ELEVENLABS_API_KEY", nil),
 				Description: "The API key for ElevenLabs.",
 			},
 		},
 		ResourcesMap: map[string]*schema.Resource{
-			"elevenlabs_agent": resourceAgent(),
-			"elevenlabs_tool":  resourceTool(),
+			"elevenlabs_agent":                    resourceAgent(),
+			"elevenlabs_tool":                     resourceTool(),
+			"elevenlabs_pronunciation_dictionary": resourcePronunciationDictionary(),
+			"elevenlabs_agent_branch":             resourceAgentBranch(),
+			"elevenlabs_agent_deployment":         resourceAgentDeployment(),
+			"elevenlabs_agent_test":               resourceAgentTest(),
+			"elevenlabs_batch_call":               resourceBatchCall(),
+			"elevenlabs_agent_widget":             resourceAgentWidget(),
+			"elevenlabs_resource_share":           resourceResourceShare(),
+			"elevenlabs_service_account":          resourceServiceAccount(),
+			"elevenlabs_service_account_api_key":  resourceServiceAccountAPIKey(),
+			"elevenlabs_convai_settings":          resourceConvAISettings(),
+			"elevenlabs_whatsapp_account":         resourceWhatsAppAccount(),
+			"elevenlabs_outbound_call":            resourceOutboundCall(),
+		},
+		DataSourcesMap: map[string]*schema.Resource{
+			"elevenlabs_agent":         dataSourceAgent(),
+			"elevenlabs_agents":        dataSourceAgents(),
+			"elevenlabs_tool":          dataSourceTool(),
+			"elevenlabs_tools":         dataSourceTools(),
+			"elevenlabs_voice":         dataSourceVoice(),
+			"elevenlabs_voices":        dataSourceVoices(),
+			"elevenlabs_models":        dataSourceModels(),
+			"elevenlabs_llms":          dataSourceLLMs(),
+			"elevenlabs_conversation":  dataSourceConversation(),
+			"elevenlabs_conversations": dataSourceConversations(),
+			"elevenlabs_subscription":  dataSourceSubscription(),
+			"elevenlabs_usage":         dataSourceUsage(),
+			"elevenlabs_openapi_tools": dataSourceOpenAPITools(),
 		},
-		DataSourcesMap:       map[string]*schema.Resource{},
 		ConfigureContextFunc: providerConfigure,
 	}
 }
@@ -0,0 +1,115 @@
+package provider
+
+import (
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// rawConfigValue returns the value at path in a raw configuration, such as
+// the one returned by GetRawConfig. Path elements are attribute names and
+// list indexes. The walk stops at the first null or unknown value and returns
+// it, so callers can tell attributes that are not configured (null) from
+// attributes that are only known after apply (unknown).
+func rawConfigValue(v cty.Value, path ...interface{}) cty.Value {
+	for _, step := range path {
+		if v.IsNull() || !v.IsKnown() {
+			return v
+		}
+		switch step := step.(type) {
+		case string:
+			if !v.Type().IsObjectType() || !v.Type().HasAttribute(step) {
+				return cty.NullVal(cty.DynamicPseudoType)
+			}
+			v = v.GetAttr(step)
+		case int:
+			if !(v.Type().IsListType() || v.Type().IsTupleType()) || v.LengthInt() <= step {
+				return cty.NullVal(cty.DynamicPseudoType)
+			}
+			v = v.Index(cty.NumberIntVal(int64(step)))
+		}
+	}
+	return v
+}
+
+// rawConfigInterface converts a raw configuration value into the types used
+// by ResourceData. Unknown values become nil, and null values become the zero
+// value of their type, as they do in ResourceData.
+func rawConfigInterface(v cty.Value) interface{} {
+	if !v.IsKnown() {
+		return nil
+	}
+
+	ty := v.Type()
+	switch {
+	case ty == cty.String:
+		if v.IsNull() {
+			return ""
+		}
+		return v.AsString()
+	case ty == cty.Bool:
+		return !v.IsNull() && v.True()
+	case ty == cty.Number:
+		if v.IsNull() {
+			return 0.0
+		}
+		f, _ := v.AsBigFloat().Float64()
+		return f
+	case ty.IsObjectType() || ty.IsMapType():
+		m := map[string]interface{}{}
+		if v.IsNull() {
+			return m
+		}
+		for it := v.ElementIterator(); it.Next(); {
+			key, value := it.Element()
+			m[key.AsString()] = rawConfigInterface(value)
+		}
+		return m
+	case ty.IsListType() || ty.IsSetType() || ty.IsTupleType():
+		l := []interface{}{}
+		if v.IsNull() {
+			return l
+		}
+		for it := v.ElementIterator(); it.Next(); {
+			_, value := it.Element()
+			l = append(l, rawConfigInterface(value))
+		}
+		return l
+	}
+	return nil
+}
+
+// rawConfigBool returns the bool attribute at path in the configuration of
+// d, or nil when it is not set. Optional and computed bools read this way
+// are only sent when configured, instead of sending false and overwriting
+// the server's value.
+func rawConfigBool(d *schema.ResourceData, path ...interface{}) *bool {
+	v := rawConfigValue(d.GetRawConfig(), path...)
+	if v.IsNull() || !v.IsKnown() || v.Type() != cty.Bool {
+		return nil
+	}
+	b := v.True()
+	return &b
+}
+
+// rawConfigInt returns the number attribute at path in the configuration of
+// d as an int, or nil when it is not set, like rawConfigBool.
+func rawConfigInt(d *schema.ResourceData, path ...interface{}) *int {
+	v := rawConfigValue(d.GetRawConfig(), path...)
+	if v.IsNull() || !v.IsKnown() || v.Type() != cty.Number {
+		return nil
+	}
+	i64, _ := v.AsBigFloat().Int64()
+	i := int(i64)
+	return &i
+}
+
+// rawConfigFloat returns the number attribute at path in the configuration of
+// d, or nil when it is not set, so that zero can be told from unset.
+func rawConfigFloat(d *schema.ResourceData, path ...interface{}) *float64 {
+	v := rawConfigValue(d.GetRawConfig(), path...)
+	if v.IsNull() || !v.IsKnown() || v.Type() != cty.Number {
+		return nil
+	}
+	f, _ := v.AsBigFloat().Float64()
+	return &f
+}
@@ -2,20 +2,80 @@ package provider
 
 import (
 	"context"
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
+// ttsModels maps the TTS models available to agents to whether they can
+// speak languages other than English.
+var ttsModels = map[string]bool{
+	"eleven_turbo_v2":        false,
+	"eleven_flash_v2":        false,
+	"eleven_turbo_v2_5":      true,
+	"eleven_flash_v2_5":      true,
+	"eleven_multilingual_v2": true,
+}
+
+var agentOutputAudioFormats = []string{
+	"pcm_8000",
+	"pcm_16000",
+	"pcm_22050",
+	"pcm_24000",
+	"pcm_44100",
+	"pcm_48000",
+	"ulaw_8000",
+}
+
+func ttsModelIDs() []string {
+	ids := make([]string, 0, len(ttsModels))
+	for id := range ttsModels {
+		ids = append(ids, id)
+	}
+	sort.Strings(ids)
+	return ids
+}
+
+func multilingualTTSModelIDs() []string {
+	var ids []string
+	for _, id := range ttsModelIDs() {
+		if ttsModels[id] {
+			ids = append(ids, id)
+		}
+	}
+	return ids
+}
+
 func resourceAgent() *schema.Resource {
 	return &schema.Resource{
 		CreateContext: resourceAgentCreate,
 		ReadContext:   resourceAgentRead,
 		UpdateContext: resourceAgentUpdate,
 		DeleteContext: resourceAgentDelete,
+		CustomizeDiff: customdiff.All(
+			validateAgentTTSModel,
+			validateAgentLLM,
+			validateAgentLanguagePresets,
+			validateAgentCustomLLM,
+			validateAgentBackupLLM,
+			validateAgentWorkflow,
+			resolveAgentPronunciationDictionaryVersions,
+		),
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+		},
 		Schema: map[string]*schema.Schema{
 			"agent_id": {
 				Type:     schema.TypeString,
@@ -30,22 +90,110 @@ func resourceAgent() *schema.Resource {
 				Optional: true,
 				Elem:     &schema.Schema{Type: schema.TypeString},
 			},
+			"workflow": workflowSchema(),
+			"test_ids": {
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Computed:    true,
+				Description: "IDs of `elevenlabs_agent_test` resources attached to the agent. When omitted, the tests attached outside Terraform are left alone.",
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"pronunciation_dictionary_versions": {
+				Type:        schema.TypeMap,
+				Computed:    true,
+				Description: "The versions used for pronunciation dictionary locators that omit `version_id`, by dictionary ID.",
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"run_tests_on_apply": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Run the attached tests after the agent is created or updated. Failed tests fail the apply of an update. On create they are only reported as warnings, as failing the apply would taint the new agent and the next apply would replace it, losing its ID and history.",
+			},
 			"conversation_config": {
 				Type:     schema.TypeList,
 				Required: true,
 				MaxItems: 1,
+				Elem:     conversationConfigResource(),
+			},
+		},
+	}
+}
+
+func conversationConfigResource() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"tts": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
-						"tts": {
+						"voice_id": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"stability": {
+							Type:     schema.TypeFloat,
+							Optional: true,
+						},
+						"speed": {
+							Type:     schema.TypeFloat,
+							Optional: true,
+						},
+						"similarity_boost": {
+							Type:     schema.TypeFloat,
+							Optional: true,
+						},
+						"model_id": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Computed:     true,
+							ValidateFunc: validation.StringInSlice(ttsModelIDs(), false),
+						},
+						"agent_output_audio_format": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Computed:     true,
+							ValidateFunc: validation.StringInSlice(agentOutputAudioFormats, false),
+						},
+						"optimize_streaming_latency": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Computed:     true,
+							ValidateFunc: validation.IntBetween(0, 4),
+						},
+						"supported_voices": {
 							Type:     schema.TypeList,
 							Optional: true,
-							MaxItems: 1,
 							Elem: &schema.Resource{
 								Schema: map[string]*schema.Schema{
+									"label": {
+										Type:     schema.TypeString,
+										Required: true,
+									},
 									"voice_id": {
+										Type:     schema.TypeString,
+										Required: true,
+									},
+									"description": {
 										Type:     schema.TypeString,
 										Optional: true,
 									},
+									"language": {
+										Type:     schema.TypeString,
+										Optional: true,
+									},
+									"model_family": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										ValidateFunc: validation.StringInSlice([]string{"turbo", "flash", "multilingual"}, false),
+									},
+									"optimize_streaming_latency": {
+										Type:         schema.TypeInt,
+										Optional:     true,
+										ValidateFunc: validation.IntBetween(0, 4),
+									},
 									"stability": {
 										Type:     schema.TypeFloat,
 										Optional: true,
@@ -61,81 +209,209 @@ func resourceAgent() *schema.Resource {
 								},
 							},
 						},
-						"agent": {
+						"pronunciation_dictionary_locators": {
+							Type:     schema.TypeList,
+							Optional: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"pronunciation_dictionary_id": {
+										Type:     schema.TypeString,
+										Required: true,
+									},
+									"version_id": {
+										Type:        schema.TypeString,
+										Optional:    true,
+										Description: "The dictionary version to use. When omitted, the agent follows the latest version: it is looked up during every plan and recorded in `pronunciation_dictionary_versions`. Reference the `version_id` attribute of an `elevenlabs_pronunciation_dictionary` resource instead to move to a new version in the same apply that changes the rules.",
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"agent": {
+				Type:     schema.TypeList,
+				Required: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"first_message": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"language": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"prompt": {
 							Type:     schema.TypeList,
 							Required: true,
 							MaxItems: 1,
 							Elem: &schema.Resource{
 								Schema: map[string]*schema.Schema{
-									"first_message": {
+									"prompt": {
 										Type:     schema.TypeString,
+										Required: true,
+									},
+									"llm": {
+										Type:        schema.TypeString,
+										Optional:    true,
+										Default:     "gpt-4o-mini",
+										Description: "The LLM that drives the agent. It is checked during plan against the models listed by the `elevenlabs_llms` data source. Deprecated models are only reported with a warning once the agent has been created or updated with them, as warnings cannot be raised during plan.",
+									},
+									"tools": {
+										Type:     schema.TypeSet,
 										Optional: true,
+										Elem:     &schema.Schema{Type: schema.TypeString},
 									},
-									"language": {
-										Type:     schema.TypeString,
+									"temperature": {
+										Type:     schema.TypeFloat,
 										Optional: true,
 									},
-									"prompt": {
+									"max_tokens": {
+										Type:     schema.TypeInt,
+										Optional: true,
+									},
+									"knowledge_base": {
 										Type:     schema.TypeList,
-										Required: true,
-										MaxItems: 1,
+										Optional: true,
 										Elem: &schema.Resource{
 											Schema: map[string]*schema.Schema{
-												"prompt": {
+												"type": {
 													Type:     schema.TypeString,
 													Required: true,
 												},
-												"llm": {
+												"name": {
 													Type:     schema.TypeString,
-													Optional: true,
-													Default:  "gpt-4o-mini",
+													Required: true,
+												},
+												"id": {
+													Type:     schema.TypeString,
+													Required: true,
 												},
-												"tools": {
-													Type:     schema.TypeSet,
+												"usage_mode": {
+													Type:     schema.TypeString,
 													Optional: true,
-													Elem:     &schema.Schema{Type: schema.TypeString},
 												},
-												"temperature": {
-													Type:     schema.TypeFloat,
+											},
+										},
+									},
+									"custom_llm": {
+										Type:        schema.TypeList,
+										Optional:    true,
+										MaxItems:    1,
+										Description: "The OpenAI-compatible endpoint used when `llm` is `custom-llm`.",
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"url": {
+													Type:     schema.TypeString,
+													Required: true,
+												},
+												"model_id": {
+													Type:     schema.TypeString,
 													Optional: true,
 												},
-												"max_tokens": {
-													Type:     schema.TypeInt,
+												"api_key_secret_id": {
+													Type:        schema.TypeString,
+													Optional:    true,
+													Description: "The ID of the workspace secret holding the API key for the endpoint.",
+												},
+												"request_headers": {
+													Type:     schema.TypeMap,
 													Optional: true,
+													Elem:     &schema.Schema{Type: schema.TypeString},
 												},
-												"knowledge_base": {
-													Type:     schema.TypeList,
+												"api_version": {
+													Type:     schema.TypeString,
 													Optional: true,
-													Elem: &schema.Resource{
-														Schema: map[string]*schema.Schema{
-															"type": {
-																Type:     schema.TypeString,
-																Required: true,
-															},
-															"name": {
-																Type:     schema.TypeString,
-																Required: true,
-															},
-															"id": {
-																Type:     schema.TypeString,
-																Required: true,
-															},
-															"usage_mode": {
-																Type:     schema.TypeString,
-																Optional: true,
-															},
-														},
-													},
 												},
 											},
 										},
 									},
+									"backup_llm_config": {
+										Type:     schema.TypeList,
+										Optional: true,
+										Computed: true,
+										MaxItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"preference": {
+													Type:         schema.TypeString,
+													Required:     true,
+													ValidateFunc: validation.StringInSlice([]string{"default", "custom", "disabled"}, false),
+												},
+												"order": {
+													Type:        schema.TypeList,
+													Optional:    true,
+													Description: "The backup models to try, in order. Only used when `preference` is `custom`.",
+													Elem:        &schema.Schema{Type: schema.TypeString},
+												},
+											},
+										},
+									},
+									"cascade_timeout_seconds": {
+										Type:         schema.TypeFloat,
+										Optional:     true,
+										Computed:     true,
+										Description:  "How long to wait for the primary LLM before cascading to a backup model.",
+										ValidateFunc: validation.FloatBetween(2, 15),
+									},
+									"reasoning_effort": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										Computed:     true,
+										ValidateFunc: validation.StringInSlice([]string{"minimal", "low", "medium", "high"}, false),
+									},
+									"ignore_default_personality": {
+										Type:     schema.TypeBool,
+										Optional: true,
+										Computed: true,
+									},
 								},
 							},
 						},
 					},
 				},
 			},
+			"conversation": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"text_only": {
+							Type:        schema.TypeBool,
+							Optional:    true,
+							Description: "Run the agent in chat mode, exchanging text messages only. Required for messaging channels such as WhatsApp.",
+						},
+					},
+				},
+			},
+			"language_presets": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"language": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"first_message": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"prompt": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"voice_id": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+					},
+				},
+			},
 		},
 	}
 }
@@ -143,98 +419,24 @@ func resourceAgent() *schema.Resource {
 func resourceAgentCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	client := m.(*Client)
 
-	agent := &Agent{
-		Name: d.Get("name").(string),
-	}
-
-	if v, ok := d.Get("tags").(*schema.Set); ok && v.Len() > 0 {
-		tags := make([]string, v.Len())
-		for i, tag := range v.List() {
-			tags[i] = tag.(string)
-		}
-		agent.Tags = tags
-	}
-
-	if v, ok := d.Get("conversation_config").([]interface{}); ok && len(v) > 0 {
-		configData := v[0].(map[string]interface{})
-		convConfig := &ConversationConfig{}
-
-		if ttsList, ok := configData["tts"].([]interface{}); ok && len(ttsList) > 0 {
-			ttsData := ttsList[0].(map[string]interface{})
-			ttsConfig := &TTSConfig{}
-			if val, ok := ttsData["voice_id"].(string); ok && val != "" {
-				ttsConfig.VoiceID = val
-			}
-			if val, ok := ttsData["stability"].(float64); ok {
-				v := val
-				ttsConfig.Stability = &v
-			}
-			if val, ok := ttsData["speed"].(float64); ok {
-				v := val
-				ttsConfig.Speed = &v
-			}
-			if val, ok := ttsData["similarity_boost"].(float64); ok {
-				v := val
-				ttsConfig.SimilarityBoost = &v
-			}
-			convConfig.TTS = ttsConfig
-		}
-
-		if agentList, ok := configData["agent"].([]interface{}); ok && len(agentList) > 0 {
-			agentData := agentList[0].(map[string]interface{})
-			agentConfig := &AgentConfig{
-				FirstMessage: agentData["first_message"].(string),
-				Language:     agentData["language"].(string),
-			}
-
-			if promptList, ok := agentData["prompt"].([]interface{}); ok && len(promptList) > 0 {
-				promptData := promptList[0].(map[string]interface{})
-				promptConfig := &PromptConfig{
-					Prompt: promptData["prompt"].(string),
-					LLM:    promptData["llm"].(string),
-				}
-				if val, ok := promptData["temperature"].(float64); ok {
-					v := val
-					promptConfig.Temperature = &v
-				}
-				if val, ok := promptData["max_tokens"].(int); ok {
-					v := val
-					promptConfig.MaxTokens = &v
-				}
-				if toolSet, ok := promptData["tools"].(*schema.Set); ok && toolSet.Len() > 0 {
-					tools := make([]string, toolSet.Len())
-					for i, tool := range toolSet.List() {
-						tools[i] = tool.(string)
-					}
-					promptConfig.ToolIDs = tools
-				}
-				if kbList, ok := promptData["knowledge_base"].([]interface{}); ok && len(kbList) > 0 {
-					kbs := make([]*KnowledgeBaseLocator, len(kbList))
-					for i, item := range kbList {
-						kbData := item.(map[string]interface{})
-						kbs[i] = &KnowledgeBaseLocator{
-							Type:      kbData["type"].(string),
-							Name:      kbData["name"].(string),
-							ID:        kbData["id"].(string),
-							UsageMode: kbData["usage_mode"].(string),
-						}
-					}
-					promptConfig.KnowledgeBase = kbs
-				}
-				agentConfig.Prompt = promptConfig
-			}
-			convConfig.Agent = agentConfig
-		}
-		agent.ConversationConfig = convConfig
-	}
-
+	agent := expandAgent(d)
 	createdAgent, err := client.CreateAgent(ctx, agent)
 	if err != nil {
 		return diag.FromErr(err)
 	}
 
 	d.SetId(createdAgent.AgentID)
-	return resourceAgentRead(ctx, d, m)
+	diags := resourceAgentRead(ctx, d, m)
+	diags = append(diags, llmDeprecationWarnings(ctx, client, d.Get("conversation_config.0.agent.0.prompt.0.llm").(string))...)
+	if d.Get("run_tests_on_apply").(bool) && !diags.HasError() {
+		// An error would taint the agent that was just created, so test
+		// failures are downgraded to warnings.
+		for _, testDiag := range runAgentTests(ctx, client, d.Id(), expandStringSet(d.Get("test_ids")), d.Timeout(schema.TimeoutCreate)) {
+			testDiag.Severity = diag.Warning
+			diags = append(diags, testDiag)
+		}
+	}
+	return diags
 }
 
 func resourceAgentRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
@@ -251,179 +453,761 @@ func resourceAgentRead(ctx context.Context, d *schema.ResourceData, m interface{
 		return nil
 	}
 
+	versions := unpinPronunciationDictionaryLocators(d, agent.ConversationConfig)
+	if err := d.Set("pronunciation_dictionary_versions", versions); err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := setAgentAttributes(d, agent); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+// setAgentAttributes sets the attributes shared by the elevenlabs_agent
+// resource and data source from agent.
+func setAgentAttributes(d *schema.ResourceData, agent *Agent) error {
 	d.Set("agent_id", agent.AgentID)
 	d.Set("name", agent.Name)
 	if err := d.Set("tags", agent.Tags); err != nil {
-		return diag.FromErr(err)
+		return err
 	}
 
 	if agent.ConversationConfig != nil {
-		convConfigMap := make(map[string]interface{})
+		if err := d.Set("conversation_config", flattenConversationConfig(agent.ConversationConfig)); err != nil {
+			return err
+		}
+	}
 
-		if agent.ConversationConfig.TTS != nil {
-			ttsMap := make(map[string]interface{})
-			ttsMap["voice_id"] = agent.ConversationConfig.TTS.VoiceID
-			if agent.ConversationConfig.TTS.Stability != nil {
-				ttsMap["stability"] = *agent.ConversationConfig.TTS.Stability
-			}
-			if agent.ConversationConfig.TTS.Speed != nil {
-				ttsMap["speed"] = *agent.ConversationConfig.TTS.Speed
+	var testIDs []string
+	if agent.PlatformSettings != nil && agent.PlatformSettings.Testing != nil {
+		for _, test := range agent.PlatformSettings.Testing.AttachedTests {
+			testIDs = append(testIDs, test.TestID)
+		}
+	}
+	if err := d.Set("test_ids", testIDs); err != nil {
+		return err
+	}
+
+	if agent.Workflow != nil && len(agent.Workflow.Nodes) > 0 {
+		return d.Set("workflow", flattenWorkflow(agent.Workflow))
+	}
+	return d.Set("workflow", nil)
+}
+
+func resourceAgentUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*Client)
+	agentID := d.Id()
+
+	updated := false
+	if d.HasChange("name") || d.HasChange("tags") || d.HasChange("conversation_config") || d.HasChange("pronunciation_dictionary_versions") || d.HasChange("workflow") || d.HasChange("test_ids") {
+		agent := expandAgent(d)
+		err := client.UpdateAgent(ctx, agentID, agent)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		updated = true
+	}
+
+	diags := resourceAgentRead(ctx, d, m)
+	if d.HasChange("conversation_config.0.agent.0.prompt.0.llm") {
+		diags = append(diags, llmDeprecationWarnings(ctx, client, d.Get("conversation_config.0.agent.0.prompt.0.llm").(string))...)
+	}
+	if updated && d.Get("run_tests_on_apply").(bool) && !diags.HasError() {
+		testDiags := runAgentTests(ctx, client, agentID, expandStringSet(d.Get("test_ids")), d.Timeout(schema.TimeoutUpdate))
+		if testDiags.HasError() {
+			// Keep the previous state, so that the next apply still sees the
+			// change and runs the tests again.
+			d.Partial(true)
+		}
+		diags = append(diags, testDiags...)
+	}
+	return diags
+}
+
+func resourceAgentDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*Client)
+	agentID := d.Id()
+
+	err := client.DeleteAgent(ctx, agentID)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId("")
+	return nil
+}
+
+func expandAgent(d *schema.ResourceData) *Agent {
+	agent := &Agent{
+		Name: d.Get("name").(string),
+	}
+
+	if v, ok := d.Get("tags").(*schema.Set); ok && v.Len() > 0 {
+		tags := make([]string, v.Len())
+		for i, tag := range v.List() {
+			tags[i] = tag.(string)
+		}
+		agent.Tags = tags
+	}
+
+	if v, ok := d.Get("conversation_config").([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		agent.ConversationConfig = expandConversationConfig(d, v[0].(map[string]interface{}))
+		pinPronunciationDictionaryLocators(d, agent.ConversationConfig)
+	}
+
+	if v, ok := d.Get("workflow").([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		agent.Workflow = expandWorkflow(v[0].(map[string]interface{}))
+	} else if d.HasChange("workflow") {
+		// An empty graph removes the workflow; leaving it out would keep it.
+		agent.Workflow = &Workflow{
+			Nodes: map[string]*WorkflowNode{},
+			Edges: map[string]*WorkflowEdge{},
+		}
+	}
+
+	// Only send the attached tests when they change, so tests attached
+	// outside Terraform are left alone by configurations that do not use them.
+	if d.HasChange("test_ids") {
+		attachedTests := []*AttachedTest{}
+		for _, testID := range expandStringSet(d.Get("test_ids")) {
+			attachedTests = append(attachedTests, &AttachedTest{TestID: testID})
+		}
+		agent.PlatformSettings = &PlatformSettings{
+			Testing: &TestingSettings{AttachedTests: attachedTests},
+		}
+	}
+
+	return agent
+}
+
+func expandConversationConfig(d *schema.ResourceData, configData map[string]interface{}) *ConversationConfig {
+	convConfig := &ConversationConfig{}
+
+	if ttsList, ok := configData["tts"].([]interface{}); ok && len(ttsList) > 0 && ttsList[0] != nil {
+		ttsData := ttsList[0].(map[string]interface{})
+		ttsConfig := &TTSConfig{}
+		if val, ok := ttsData["voice_id"].(string); ok && val != "" {
+			ttsConfig.VoiceID = val
+		}
+		if val, ok := ttsData["stability"].(float64); ok {
+			v := val
+			ttsConfig.Stability = &v
+		}
+		if val, ok := ttsData["speed"].(float64); ok {
+			v := val
+			ttsConfig.Speed = &v
+		}
+		if val, ok := ttsData["similarity_boost"].(float64); ok {
+			v := val
+			ttsConfig.SimilarityBoost = &v
+		}
+		if val, ok := ttsData["model_id"].(string); ok && val != "" {
+			ttsConfig.ModelID = val
+		}
+		if val, ok := ttsData["agent_output_audio_format"].(string); ok && val != "" {
+			ttsConfig.AgentOutputAudioFormat = val
+		}
+		ttsConfig.OptimizeStreamingLatency = rawConfigInt(d, "conversation_config", 0, "tts", 0, "optimize_streaming_latency")
+		if voiceList, ok := ttsData["supported_voices"].([]interface{}); ok && len(voiceList) > 0 {
+			voices := make([]*SupportedVoice, len(voiceList))
+			for i, item := range voiceList {
+				voiceData := item.(map[string]interface{})
+				voice := &SupportedVoice{
+					Label:       voiceData["label"].(string),
+					VoiceID:     voiceData["voice_id"].(string),
+					Description: voiceData["description"].(string),
+					Language:    voiceData["language"].(string),
+					ModelFamily: voiceData["model_family"].(string),
+				}
+				// Read from the configuration, so that 0 can be set.
+				voicePath := []interface{}{"conversation_config", 0, "tts", 0, "supported_voices", i}
+				voice.OptimizeStreamingLatency = rawConfigInt(d, append(voicePath, "optimize_streaming_latency")...)
+				voice.Stability = rawConfigFloat(d, append(voicePath, "stability")...)
+				voice.Speed = rawConfigFloat(d, append(voicePath, "speed")...)
+				voice.SimilarityBoost = rawConfigFloat(d, append(voicePath, "similarity_boost")...)
+				voices[i] = voice
 			}
-			if agent.ConversationConfig.TTS.SimilarityBoost != nil {
-				ttsMap["similarity_boost"] = *agent.ConversationConfig.TTS.SimilarityBoost
+			ttsConfig.SupportedVoices = voices
+		}
+		if locList, ok := ttsData["pronunciation_dictionary_locators"].([]interface{}); ok && len(locList) > 0 {
+			locators := make([]*PronunciationDictionaryLocator, len(locList))
+			for i, item := range locList {
+				locData := item.(map[string]interface{})
+				locators[i] = &PronunciationDictionaryLocator{
+					PronunciationDictionaryID: locData["pronunciation_dictionary_id"].(string),
+					VersionID:                 locData["version_id"].(string),
+				}
 			}
-			convConfigMap["tts"] = []interface{}{ttsMap}
+			ttsConfig.PronunciationDictionaryLocators = locators
 		}
+		convConfig.TTS = ttsConfig
+	}
 
-		if agent.ConversationConfig.Agent != nil {
-			agentConfigMap := make(map[string]interface{})
-			agentConfigMap["first_message"] = agent.ConversationConfig.Agent.FirstMessage
-			agentConfigMap["language"] = agent.ConversationConfig.Agent.Language
+	if agentList, ok := configData["agent"].([]interface{}); ok && len(agentList) > 0 && agentList[0] != nil {
+		agentData := agentList[0].(map[string]interface{})
+		agentConfig := &AgentConfig{
+			FirstMessage: agentData["first_message"].(string),
+			Language:     agentData["language"].(string),
+		}
 
-			if agent.ConversationConfig.Agent.Prompt != nil {
-				promptMap := make(map[string]interface{})
-				promptMap["prompt"] = agent.ConversationConfig.Agent.Prompt.Prompt
-				promptMap["llm"] = agent.ConversationConfig.Agent.Prompt.LLM
-				promptMap["tools"] = agent.ConversationConfig.Agent.Prompt.ToolIDs
-				if agent.ConversationConfig.Agent.Prompt.Temperature != nil {
-					promptMap["temperature"] = *agent.ConversationConfig.Agent.Prompt.Temperature
+		if promptList, ok := agentData["prompt"].([]interface{}); ok && len(promptList) > 0 && promptList[0] != nil {
+			promptData := promptList[0].(map[string]interface{})
+			promptConfig := &PromptConfig{
+				Prompt: promptData["prompt"].(string),
+				LLM:    promptData["llm"].(string),
+			}
+			if val, ok := promptData["temperature"].(float64); ok {
+				v := val
+				promptConfig.Temperature = &v
+			}
+			if val, ok := promptData["max_tokens"].(int); ok {
+				v := val
+				promptConfig.MaxTokens = &v
+			}
+			if toolSet, ok := promptData["tools"].(*schema.Set); ok && toolSet.Len() > 0 {
+				tools := make([]string, toolSet.Len())
+				for i, tool := range toolSet.List() {
+					tools[i] = tool.(string)
+				}
+				promptConfig.ToolIDs = tools
+			}
+			if kbList, ok := promptData["knowledge_base"].([]interface{}); ok && len(kbList) > 0 {
+				kbs := make([]*KnowledgeBaseLocator, len(kbList))
+				for i, item := range kbList {
+					kbData := item.(map[string]interface{})
+					kbs[i] = &KnowledgeBaseLocator{
+						Type:      kbData["type"].(string),
+						Name:      kbData["name"].(string),
+						ID:        kbData["id"].(string),
+						UsageMode: kbData["usage_mode"].(string),
+					}
+				}
+				promptConfig.KnowledgeBase = kbs
+			}
+			if customList, ok := promptData["custom_llm"].([]interface{}); ok && len(customList) > 0 && customList[0] != nil {
+				customData := customList[0].(map[string]interface{})
+				customLLM := &CustomLLM{
+					URL:        customData["url"].(string),
+					ModelID:    customData["model_id"].(string),
+					APIVersion: customData["api_version"].(string),
 				}
-				if agent.ConversationConfig.Agent.Prompt.MaxTokens != nil {
-					promptMap["max_tokens"] = *agent.ConversationConfig.Agent.Prompt.MaxTokens
+				if val := customData["api_key_secret_id"].(string); val != "" {
+					customLLM.APIKey = &SecretReference{SecretID: val}
 				}
-				if agent.ConversationConfig.Agent.Prompt.KnowledgeBase != nil {
-					kbList := make([]interface{}, len(agent.ConversationConfig.Agent.Prompt.KnowledgeBase))
-					for i, kb := range agent.ConversationConfig.Agent.Prompt.KnowledgeBase {
-						kbMap := make(map[string]interface{})
-						kbMap["type"] = kb.Type
-						kbMap["name"] = kb.Name
-						kbMap["id"] = kb.ID
-						kbMap["usage_mode"] = kb.UsageMode
-						kbList[i] = kbMap
+				if headers, ok := customData["request_headers"].(map[string]interface{}); ok && len(headers) > 0 {
+					customLLM.RequestHeaders = make(map[string]string)
+					for key, val := range headers {
+						customLLM.RequestHeaders[key] = val.(string)
 					}
-					promptMap["knowledge_base"] = kbList
 				}
-				agentConfigMap["prompt"] = []interface{}{promptMap}
+				promptConfig.CustomLLM = customLLM
 			}
-			convConfigMap["agent"] = []interface{}{agentConfigMap}
+			if backupList, ok := promptData["backup_llm_config"].([]interface{}); ok && len(backupList) > 0 && backupList[0] != nil {
+				backupData := backupList[0].(map[string]interface{})
+				backupConfig := &BackupLLMConfig{
+					Preference: backupData["preference"].(string),
+				}
+				for _, llm := range backupData["order"].([]interface{}) {
+					backupConfig.Order = append(backupConfig.Order, llm.(string))
+				}
+				promptConfig.BackupLLMConfig = backupConfig
+			}
+			if val, ok := promptData["cascade_timeout_seconds"].(float64); ok && val != 0 {
+				v := val
+				promptConfig.CascadeTimeoutSeconds = &v
+			}
+			if val, ok := promptData["reasoning_effort"].(string); ok && val != "" {
+				promptConfig.ReasoningEffort = val
+			}
+			promptConfig.IgnoreDefaultPersonality = rawConfigBool(d, "conversation_config", 0, "agent", 0, "prompt", 0, "ignore_default_personality")
+			agentConfig.Prompt = promptConfig
 		}
+		convConfig.Agent = agentConfig
+	}
 
-		if err := d.Set("conversation_config", []interface{}{convConfigMap}); err != nil {
-			return diag.FromErr(err)
+	if conversationList, ok := configData["conversation"].([]interface{}); ok && len(conversationList) > 0 && conversationList[0] != nil {
+		conversationData := conversationList[0].(map[string]interface{})
+		textOnly := conversationData["text_only"].(bool)
+		convConfig.Conversation = &ConversationSettings{TextOnly: &textOnly}
+	}
+
+	if presetSet, ok := configData["language_presets"].(*schema.Set); ok && presetSet.Len() > 0 {
+		convConfig.LanguagePresets = make(map[string]*LanguagePreset)
+		for _, item := range presetSet.List() {
+			presetData := item.(map[string]interface{})
+			overrides := &ConversationConfig{}
+			if val := presetData["first_message"].(string); val != "" {
+				overrides.Agent = &AgentConfig{FirstMessage: val}
+			}
+			if val := presetData["prompt"].(string); val != "" {
+				if overrides.Agent == nil {
+					overrides.Agent = &AgentConfig{}
+				}
+				overrides.Agent.Prompt = &PromptConfig{Prompt: val}
+			}
+			if val := presetData["voice_id"].(string); val != "" {
+				overrides.TTS = &TTSConfig{VoiceID: val}
+			}
+			convConfig.LanguagePresets[presetData["language"].(string)] = &LanguagePreset{Overrides: overrides}
 		}
 	}
 
-	return nil
+	return convConfig
 }
 
-func resourceAgentUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	client := m.(*Client)
-	agentID := d.Id()
+func flattenConversationConfig(convConfig *ConversationConfig) []interface{} {
+	convConfigMap := make(map[string]interface{})
 
-	if d.HasChange("name") || d.HasChange("tags") || d.HasChange("conversation_config") {
-		agent := &Agent{
-			Name: d.Get("name").(string),
+	if convConfig.TTS != nil {
+		ttsMap := make(map[string]interface{})
+		ttsMap["voice_id"] = convConfig.TTS.VoiceID
+		if convConfig.TTS.Stability != nil {
+			ttsMap["stability"] = *convConfig.TTS.Stability
 		}
-
-		if v, ok := d.Get("tags").(*schema.Set); ok && v.Len() > 0 {
-			tags := make([]string, v.Len())
-			for i, tag := range v.List() {
-				tags[i] = tag.(string)
+		if convConfig.TTS.Speed != nil {
+			ttsMap["speed"] = *convConfig.TTS.Speed
+		}
+		if convConfig.TTS.SimilarityBoost != nil {
+			ttsMap["similarity_boost"] = *convConfig.TTS.SimilarityBoost
+		}
+		ttsMap["model_id"] = convConfig.TTS.ModelID
+		ttsMap["agent_output_audio_format"] = convConfig.TTS.AgentOutputAudioFormat
+		if convConfig.TTS.OptimizeStreamingLatency != nil {
+			ttsMap["optimize_streaming_latency"] = *convConfig.TTS.OptimizeStreamingLatency
+		}
+		if convConfig.TTS.SupportedVoices != nil {
+			voiceList := make([]interface{}, len(convConfig.TTS.SupportedVoices))
+			for i, voice := range convConfig.TTS.SupportedVoices {
+				voiceMap := make(map[string]interface{})
+				voiceMap["label"] = voice.Label
+				voiceMap["voice_id"] = voice.VoiceID
+				voiceMap["description"] = voice.Description
+				voiceMap["language"] = voice.Language
+				voiceMap["model_family"] = voice.ModelFamily
+				if voice.OptimizeStreamingLatency != nil {
+					voiceMap["optimize_streaming_latency"] = *voice.OptimizeStreamingLatency
+				}
+				if voice.Stability != nil {
+					voiceMap["stability"] = *voice.Stability
+				}
+				if voice.Speed != nil {
+					voiceMap["speed"] = *voice.Speed
+				}
+				if voice.SimilarityBoost != nil {
+					voiceMap["similarity_boost"] = *voice.SimilarityBoost
+				}
+				voiceList[i] = voiceMap
+			}
+			ttsMap["supported_voices"] = voiceList
+		}
+		if convConfig.TTS.PronunciationDictionaryLocators != nil {
+			locList := make([]interface{}, len(convConfig.TTS.PronunciationDictionaryLocators))
+			for i, loc := range convConfig.TTS.PronunciationDictionaryLocators {
+				locMap := make(map[string]interface{})
+				locMap["pronunciation_dictionary_id"] = loc.PronunciationDictionaryID
+				locMap["version_id"] = loc.VersionID
+				locList[i] = locMap
 			}
-			agent.Tags = tags
+			ttsMap["pronunciation_dictionary_locators"] = locList
 		}
+		convConfigMap["tts"] = []interface{}{ttsMap}
+	}
 
-		if v, ok := d.Get("conversation_config").([]interface{}); ok && len(v) > 0 {
-			configData := v[0].(map[string]interface{})
-			convConfig := &ConversationConfig{}
+	if convConfig.Agent != nil {
+		agentConfigMap := make(map[string]interface{})
+		agentConfigMap["first_message"] = convConfig.Agent.FirstMessage
+		agentConfigMap["language"] = convConfig.Agent.Language
 
-			if ttsList, ok := configData["tts"].([]interface{}); ok && len(ttsList) > 0 {
-				ttsData := ttsList[0].(map[string]interface{})
-				ttsConfig := &TTSConfig{}
-				if val, ok := ttsData["voice_id"].(string); ok && val != "" {
-					ttsConfig.VoiceID = val
-				}
-				if val, ok := ttsData["stability"].(float64); ok {
-					v := val
-					ttsConfig.Stability = &v
+		if convConfig.Agent.Prompt != nil {
+			promptMap := make(map[string]interface{})
+			promptMap["prompt"] = convConfig.Agent.Prompt.Prompt
+			promptMap["llm"] = convConfig.Agent.Prompt.LLM
+			promptMap["tools"] = convConfig.Agent.Prompt.ToolIDs
+			if convConfig.Agent.Prompt.Temperature != nil {
+				promptMap["temperature"] = *convConfig.Agent.Prompt.Temperature
+			}
+			if convConfig.Agent.Prompt.MaxTokens != nil {
+				promptMap["max_tokens"] = *convConfig.Agent.Prompt.MaxTokens
+			}
+			if convConfig.Agent.Prompt.KnowledgeBase != nil {
+				kbList := make([]interface{}, len(convConfig.Agent.Prompt.KnowledgeBase))
+				for i, kb := range convConfig.Agent.Prompt.KnowledgeBase {
+					kbMap := make(map[string]interface{})
+					kbMap["type"] = kb.Type
+					kbMap["name"] = kb.Name
+					kbMap["id"] = kb.ID
+					kbMap["usage_mode"] = kb.UsageMode
+					kbList[i] = kbMap
 				}
-				if val, ok := ttsData["speed"].(float64); ok {
-					v := val
-					ttsConfig.Speed = &v
+				promptMap["knowledge_base"] = kbList
+			}
+			if convConfig.Agent.Prompt.CustomLLM != nil {
+				customLLM := convConfig.Agent.Prompt.CustomLLM
+				customMap := make(map[string]interface{})
+				customMap["url"] = customLLM.URL
+				customMap["model_id"] = customLLM.ModelID
+				customMap["api_version"] = customLLM.APIVersion
+				if customLLM.APIKey != nil {
+					customMap["api_key_secret_id"] = customLLM.APIKey.SecretID
 				}
-				if val, ok := ttsData["similarity_boost"].(float64); ok {
-					v := val
-					ttsConfig.SimilarityBoost = &v
+				if customLLM.RequestHeaders != nil {
+					customMap["request_headers"] = customLLM.RequestHeaders
 				}
-				convConfig.TTS = ttsConfig
+				promptMap["custom_llm"] = []interface{}{customMap}
+			}
+			if convConfig.Agent.Prompt.BackupLLMConfig != nil {
+				backupMap := make(map[string]interface{})
+				backupMap["preference"] = convConfig.Agent.Prompt.BackupLLMConfig.Preference
+				backupMap["order"] = convConfig.Agent.Prompt.BackupLLMConfig.Order
+				promptMap["backup_llm_config"] = []interface{}{backupMap}
 			}
+			if convConfig.Agent.Prompt.CascadeTimeoutSeconds != nil {
+				promptMap["cascade_timeout_seconds"] = *convConfig.Agent.Prompt.CascadeTimeoutSeconds
+			}
+			promptMap["reasoning_effort"] = convConfig.Agent.Prompt.ReasoningEffort
+			if convConfig.Agent.Prompt.IgnoreDefaultPersonality != nil {
+				promptMap["ignore_default_personality"] = *convConfig.Agent.Prompt.IgnoreDefaultPersonality
+			}
+			agentConfigMap["prompt"] = []interface{}{promptMap}
+		}
+		convConfigMap["agent"] = []interface{}{agentConfigMap}
+	}
 
-			if agentList, ok := configData["agent"].([]interface{}); ok && len(agentList) > 0 {
-				agentData := agentList[0].(map[string]interface{})
-				agentConfig := &AgentConfig{
-					FirstMessage: agentData["first_message"].(string),
-					Language:     agentData["language"].(string),
-				}
+	if convConfig.Conversation != nil {
+		conversationMap := make(map[string]interface{})
+		if convConfig.Conversation.TextOnly != nil {
+			conversationMap["text_only"] = *convConfig.Conversation.TextOnly
+		}
+		convConfigMap["conversation"] = []interface{}{conversationMap}
+	}
 
-				if promptList, ok := agentData["prompt"].([]interface{}); ok && len(promptList) > 0 {
-					promptData := promptList[0].(map[string]interface{})
-					promptConfig := &PromptConfig{
-						Prompt: promptData["prompt"].(string),
-						LLM:    promptData["llm"].(string),
+	if convConfig.LanguagePresets != nil {
+		presetList := make([]interface{}, 0, len(convConfig.LanguagePresets))
+		for language, preset := range convConfig.LanguagePresets {
+			presetMap := make(map[string]interface{})
+			presetMap["language"] = language
+			if preset != nil && preset.Overrides != nil {
+				if preset.Overrides.Agent != nil {
+					presetMap["first_message"] = preset.Overrides.Agent.FirstMessage
+					if preset.Overrides.Agent.Prompt != nil {
+						presetMap["prompt"] = preset.Overrides.Agent.Prompt.Prompt
 					}
-					if val, ok := promptData["temperature"].(float64); ok {
-						v := val
-						promptConfig.Temperature = &v
-					}
-					if val, ok := promptData["max_tokens"].(int); ok {
-						v := val
-						promptConfig.MaxTokens = &v
-					}
-					if toolSet, ok := promptData["tools"].(*schema.Set); ok && toolSet.Len() > 0 {
-						tools := make([]string, toolSet.Len())
-						for i, tool := range toolSet.List() {
-							tools[i] = tool.(string)
-						}
-						promptConfig.ToolIDs = tools
-					}
-					if kbList, ok := promptData["knowledge_base"].([]interface{}); ok && len(kbList) > 0 {
-						kbs := make([]*KnowledgeBaseLocator, len(kbList))
-						for i, item := range kbList {
-							kbData := item.(map[string]interface{})
-							kbs[i] = &KnowledgeBaseLocator{
-								Type:      kbData["type"].(string),
-								Name:      kbData["name"].(string),
-								ID:        kbData["id"].(string),
-								UsageMode: kbData["usage_mode"].(string),
-							}
-						}
-						promptConfig.KnowledgeBase = kbs
-					}
-					agentConfig.Prompt = promptConfig
 				}
-				convConfig.Agent = agentConfig
+				if preset.Overrides.TTS != nil {
+					presetMap["voice_id"] = preset.Overrides.TTS.VoiceID
+				}
 			}
-			agent.ConversationConfig = convConfig
+			presetList = append(presetList, presetMap)
 		}
+		convConfigMap["language_presets"] = presetList
+	}
 
-		err := client.UpdateAgent(ctx, agentID, agent)
-		if err != nil {
-			return diag.FromErr(err)
+	return []interface{}{convConfigMap}
+}
+
+// validateAgentTTSModel rejects English-only TTS models for agents, language
+// presets or supported voices that speak another language.
+func validateAgentTTSModel(ctx context.Context, d *schema.ResourceDiff, m interface{}) error {
+	modelID := d.Get("conversation_config.0.tts.0.model_id").(string)
+	if modelID == "" {
+		return nil
+	}
+	multilingual, ok := ttsModels[modelID]
+	if !ok || multilingual {
+		return nil
+	}
+
+	languages := []string{d.Get("conversation_config.0.agent.0.language").(string)}
+	for _, item := range d.Get("conversation_config.0.tts.0.supported_voices").([]interface{}) {
+		voice := item.(map[string]interface{})
+		if voice["model_family"].(string) == "" {
+			languages = append(languages, voice["language"].(string))
+		}
+	}
+	if presetSet, ok := d.Get("conversation_config.0.language_presets").(*schema.Set); ok {
+		for _, item := range presetSet.List() {
+			languages = append(languages, item.(map[string]interface{})["language"].(string))
+		}
+	}
+	for _, language := range languages {
+		if language != "" && language != "en" {
+			return fmt.Errorf("TTS model %q only supports English, but the agent is configured for language %q; use a multilingual model such as %v", modelID, language, multilingualTTSModelIDs())
 		}
 	}
+	return nil
+}
 
-	return resourceAgentRead(ctx, d, m)
+// validateAgentLanguagePresets ensures each language has at most one preset,
+// as presets are keyed by language code in the API.
+func validateAgentLanguagePresets(ctx context.Context, d *schema.ResourceDiff, m interface{}) error {
+	presetSet, ok := d.Get("conversation_config.0.language_presets").(*schema.Set)
+	if !ok {
+		return nil
+	}
+	seen := make(map[string]bool)
+	for _, item := range presetSet.List() {
+		language := item.(map[string]interface{})["language"].(string)
+		if language == "" {
+			continue
+		}
+		if seen[language] {
+			return fmt.Errorf("duplicate language_presets entry for language %q", language)
+		}
+		seen[language] = true
+	}
+	return nil
 }
 
-func resourceAgentDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	client := m.(*Client)
-	agentID := d.Id()
+// validateAgentCustomLLM requires a custom_llm block when the prompt uses the
+// custom-llm model.
+func validateAgentCustomLLM(ctx context.Context, d *schema.ResourceDiff, m interface{}) error {
+	if d.Get("conversation_config.0.agent.0.prompt.0.llm").(string) != "custom-llm" {
+		return nil
+	}
+	if len(d.Get("conversation_config.0.agent.0.prompt.0.custom_llm").([]interface{})) == 0 {
+		return fmt.Errorf("conversation_config.0.agent.0.prompt.0.custom_llm is required when llm is \"custom-llm\"")
+	}
+	return nil
+}
 
-	err := client.DeleteAgent(ctx, agentID)
+// validateAgentBackupLLM checks that the custom preference comes with an
+// explicit backup order.
+func validateAgentBackupLLM(ctx context.Context, d *schema.ResourceDiff, m interface{}) error {
+	backupList := d.Get("conversation_config.0.agent.0.prompt.0.backup_llm_config").([]interface{})
+	if len(backupList) == 0 || backupList[0] == nil || !d.NewValueKnown("conversation_config.0.agent.0.prompt.0.backup_llm_config") {
+		return nil
+	}
+	backupData := backupList[0].(map[string]interface{})
+	if backupData["preference"].(string) == "custom" && len(backupData["order"].([]interface{})) == 0 {
+		return fmt.Errorf("backup_llm_config.order must list at least one model when preference is \"custom\"")
+	}
+	return nil
+}
+
+// validateAgentLLM checks prompt.llm against the LLM catalog. The check is
+// skipped when the catalog cannot be fetched, leaving it to the API.
+// Deprecated models pass: CustomizeDiff can only return errors, so they are
+// reported by llmDeprecationWarnings after apply instead.
+func validateAgentLLM(ctx context.Context, d *schema.ResourceDiff, m interface{}) error {
+	key := "conversation_config.0.agent.0.prompt.0.llm"
+	llm := d.Get(key).(string)
+	if llm == "" || llm == "custom-llm" || !d.NewValueKnown(key) {
+		return nil
+	}
+
+	client, ok := m.(*Client)
+	if !ok {
+		return nil
+	}
+	catalog, err := client.LLMCatalog(ctx)
+	if err != nil || len(catalog) == 0 {
+		return nil
+	}
+	if findLLM(catalog, llm) != nil {
+		return nil
+	}
+
+	ids := make([]string, 0, len(catalog))
+	for _, info := range catalog {
+		ids = append(ids, info.LLM)
+	}
+	sort.Strings(ids)
+	return fmt.Errorf("%s: unsupported LLM %q, expected one of %s", key, llm, strings.Join(ids, ", "))
+}
+
+// llmDeprecationWarnings returns a warning when llm is deprecated in the LLM
+// catalog. It is called after llm has been applied rather than from Read, so
+// that the warning is about the model the configuration asks for. The plan
+// cannot carry warnings, so this is the earliest the warning can appear.
+func llmDeprecationWarnings(ctx context.Context, client *Client, llm string) diag.Diagnostics {
+	if llm == "" {
+		return nil
+	}
+	catalog, err := client.LLMCatalog(ctx)
+	if err != nil {
+		return nil
+	}
+	info := findLLM(catalog, llm)
+	if info == nil || info.DeprecationInfo == nil || !info.DeprecationInfo.IsDeprecated {
+		return nil
+	}
+
+	detail := fmt.Sprintf("The LLM %q is deprecated", llm)
+	if date := info.DeprecationInfo.DeprecationDateUnix; date != nil {
+		detail += fmt.Sprintf(" and will stop working on %s", time.Unix(*date, 0).UTC().Format("2006-01-02"))
+	}
+	if replacement := info.DeprecationInfo.ReplacementModel; replacement != "" {
+		detail += fmt.Sprintf(". Switch to %q instead", replacement)
+	}
+	return diag.Diagnostics{{
+		Severity: diag.Warning,
+		Summary:  "Deprecated LLM",
+		Detail:   detail + ".",
+	}}
+}
+
+func findLLM(catalog []*LLMInfo, llm string) *LLMInfo {
+	for _, info := range catalog {
+		if info.LLM == llm {
+			return info
+		}
+	}
+	return nil
+}
+
+// runAgentTests runs the given tests against the agent and waits for them to
+// finish, returning one error diagnostic per failed test.
+func runAgentTests(ctx context.Context, client *Client, agentID string, testIDs []string, timeout time.Duration) diag.Diagnostics {
+	if len(testIDs) == 0 {
+		return nil
+	}
+
+	invocation, err := client.RunAgentTests(ctx, agentID, testIDs)
 	if err != nil {
 		return diag.FromErr(err)
 	}
 
-	d.SetId("")
+	stateConf := &retry.StateChangeConf{
+		Pending: []string{"pending"},
+		Target:  []string{"completed"},
+		Refresh: func() (interface{}, string, error) {
+			inv, err := client.GetAgentTestInvocation(ctx, invocation.ID)
+			if err != nil {
+				return nil, "", err
+			}
+			if len(inv.TestRuns) == 0 {
+				return nil, "", fmt.Errorf("test invocation %s has no test runs", invocation.ID)
+			}
+			// Runs with any other status than passed count as failed
+			// below, so an unknown status never passes.
+			for _, run := range inv.TestRuns {
+				if run.Status == "pending" || run.Status == "running" || run.Status == "" {
+					return inv, "pending", nil
+				}
+			}
+			return inv, "completed", nil
+		},
+		Timeout:    timeout,
+		Delay:      2 * time.Second,
+		MinTimeout: 2 * time.Second,
+	}
+	result, err := stateConf.WaitForStateContext(ctx)
+	if err != nil {
+		return diag.Errorf("waiting for tests of agent %s: %s", agentID, err)
+	}
+
+	var diags diag.Diagnostics
+	for _, run := range result.(*AgentTestInvocation).TestRuns {
+		if run.Status == "passed" {
+			continue
+		}
+		name := run.TestName
+		if name == "" {
+			name = run.TestID
+		}
+		detail := fmt.Sprintf("Test run %s finished with status %q.", run.TestRunID, run.Status)
+		if run.ConditionResult != nil && run.ConditionResult.Rationale != nil && run.ConditionResult.Rationale.Summary != "" {
+			detail += " " + run.ConditionResult.Rationale.Summary
+		}
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  fmt.Sprintf("Agent test %q failed", name),
+			Detail:   detail,
+		})
+	}
+	return diags
+}
+
+func expandStringSet(v interface{}) []string {
+	set, ok := v.(*schema.Set)
+	if !ok {
+		return nil
+	}
+	values := make([]string, set.Len())
+	for i, item := range set.List() {
+		values[i] = item.(string)
+	}
+	return values
+}
+
+// resolveAgentPronunciationDictionaryVersions looks up the latest version of
+// the dictionaries whose locators omit version_id, so that agents move to a
+// new version as soon as one is created.
+func resolveAgentPronunciationDictionaryVersions(ctx context.Context, d *schema.ResourceDiff, m interface{}) error {
+	locators := rawConfigValue(d.GetRawConfig(), "conversation_config", 0, "tts", 0, "pronunciation_dictionary_locators")
+	if !locators.IsKnown() {
+		return d.SetNewComputed("pronunciation_dictionary_versions")
+	}
+
+	client := m.(*Client)
+	versions := map[string]interface{}{}
+	if !locators.IsNull() {
+		for it := locators.ElementIterator(); it.Next(); {
+			_, loc := it.Element()
+			if !loc.GetAttr("version_id").IsNull() {
+				continue
+			}
+			dictionaryID := loc.GetAttr("pronunciation_dictionary_id")
+			if !dictionaryID.IsKnown() {
+				return d.SetNewComputed("pronunciation_dictionary_versions")
+			}
+			if dictionaryID.IsNull() {
+				continue
+			}
+			dict, err := client.GetPronunciationDictionary(ctx, dictionaryID.AsString())
+			if err != nil {
+				return err
+			}
+			if dict == nil {
+				return fmt.Errorf("pronunciation dictionary %q not found", dictionaryID.AsString())
+			}
+			versions[dictionaryID.AsString()] = dict.LatestVersionID
+		}
+	}
+
+	if !reflect.DeepEqual(versions, d.Get("pronunciation_dictionary_versions")) {
+		return d.SetNew("pronunciation_dictionary_versions", versions)
+	}
 	return nil
 }
+
+// pinPronunciationDictionaryLocators sets the version of locators that omit
+// version_id to the one resolved during plan.
+func pinPronunciationDictionaryLocators(d *schema.ResourceData, convConfig *ConversationConfig) {
+	if convConfig.TTS == nil {
+		return
+	}
+	versions := d.Get("pronunciation_dictionary_versions").(map[string]interface{})
+	for _, loc := range convConfig.TTS.PronunciationDictionaryLocators {
+		if loc.VersionID == "" {
+			loc.VersionID, _ = versions[loc.PronunciationDictionaryID].(string)
+		}
+	}
+}
+
+// unpinPronunciationDictionaryLocators clears the version of the locators in
+// convConfig that omit version_id in d, since they follow the latest
+// dictionary version, and returns those versions by dictionary ID.
+func unpinPronunciationDictionaryLocators(d *schema.ResourceData, convConfig *ConversationConfig) map[string]interface{} {
+	versions := map[string]interface{}{}
+	if convConfig == nil || convConfig.TTS == nil {
+		return versions
+	}
+
+	unpinned := map[string]bool{}
+	for _, item := range d.Get("conversation_config.0.tts.0.pronunciation_dictionary_locators").([]interface{}) {
+		loc := item.(map[string]interface{})
+		if loc["version_id"].(string) == "" {
+			unpinned[loc["pronunciation_dictionary_id"].(string)] = true
+		}
+	}
+	for _, loc := range convConfig.TTS.PronunciationDictionaryLocators {
+		if unpinned[loc.PronunciationDictionaryID] {
+			versions[loc.PronunciationDictionaryID] = loc.VersionID
+			loc.VersionID = ""
+		}
+	}
+	return versions
+}
@@ -0,0 +1,331 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func resourceAgentBranch() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceAgentBranchCreate,
+		ReadContext:   resourceAgentBranchRead,
+		UpdateContext: resourceAgentBranchUpdate,
+		DeleteContext: resourceAgentBranchDelete,
+		CustomizeDiff: customdiff.All(
+			validateAgentTTSModel,
+			validateAgentLLM,
+			validateAgentLanguagePresets,
+			validateAgentCustomLLM,
+			validateAgentBackupLLM,
+			resolveAgentPronunciationDictionaryVersions,
+			customdiff.ComputedIf("version_id", func(ctx context.Context, d *schema.ResourceDiff, m interface{}) bool {
+				return d.HasChange("conversation_config") || d.HasChange("pronunciation_dictionary_versions")
+			}),
+		),
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceAgentBranchImport,
+		},
+		Schema: map[string]*schema.Schema{
+			"agent_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"branch_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"parent_version_id": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				ForceNew:    true,
+				Description: "The agent version to fork the branch from. Defaults to the current version of the agent's main branch.",
+			},
+			"version_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"conversation_config": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				MaxItems:    1,
+				Computed:    true,
+				Description: "Overrides applied to the branch on top of the parent version. Only the settings set here are sent; the others are inherited from the parent version and read back as computed values.",
+				Elem:        branchConversationConfigResource(),
+			},
+			"pronunciation_dictionary_versions": {
+				Type:        schema.TypeMap,
+				Computed:    true,
+				Description: "The versions used for pronunciation dictionary locators that omit `version_id`, by dictionary ID.",
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+		},
+	}
+}
+
+func resourceAgentBranchCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*Client)
+	agentID := d.Get("agent_id").(string)
+
+	parentVersionID := d.Get("parent_version_id").(string)
+	if parentVersionID == "" {
+		agent, err := client.GetAgent(ctx, agentID)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		if agent == nil {
+			return diag.Errorf("agent %q not found", agentID)
+		}
+		parentVersionID = agent.VersionID
+	}
+
+	branch := &AgentBranchRequest{
+		ParentVersionID: parentVersionID,
+		Name:            d.Get("name").(string),
+		Description:     d.Get("description").(string),
+	}
+	branch.ConversationConfig = expandBranchConversationConfig(d)
+
+	createdBranch, err := client.CreateAgentBranch(ctx, agentID, branch)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(createdBranch.CreatedBranchID)
+	d.Set("parent_version_id", parentVersionID)
+	return resourceAgentBranchRead(ctx, d, m)
+}
+
+func resourceAgentBranchRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*Client)
+	agentID := d.Get("agent_id").(string)
+	branchID := d.Id()
+
+	branch, err := client.GetAgentBranch(ctx, agentID, branchID)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if branch == nil {
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("branch_id", branch.ID)
+	d.Set("name", branch.Name)
+	d.Set("description", branch.Description)
+
+	agent, err := client.GetAgentOnBranch(ctx, agentID, branchID)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if agent == nil {
+		d.SetId("")
+		return nil
+	}
+	d.Set("version_id", agent.VersionID)
+	if err := d.Set("pronunciation_dictionary_versions", unpinPronunciationDictionaryLocators(d, agent.ConversationConfig)); err != nil {
+		return diag.FromErr(err)
+	}
+
+	if agent.ConversationConfig != nil {
+		if err := d.Set("conversation_config", flattenConversationConfig(agent.ConversationConfig)); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return nil
+}
+
+func resourceAgentBranchUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*Client)
+	agentID := d.Get("agent_id").(string)
+	branchID := d.Id()
+
+	if d.HasChange("name") || d.HasChange("description") {
+		err := client.UpdateAgentBranch(ctx, agentID, branchID, d.Get("name").(string), d.Get("description").(string))
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	if d.HasChange("conversation_config") || d.HasChange("pronunciation_dictionary_versions") {
+		agent := &Agent{ConversationConfig: expandBranchConversationConfig(d)}
+
+		err := client.UpdateAgentOnBranch(ctx, agentID, branchID, agent)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return resourceAgentBranchRead(ctx, d, m)
+}
+
+func resourceAgentBranchDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*Client)
+
+	err := client.ArchiveAgentBranch(ctx, d.Get("agent_id").(string), d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId("")
+	return nil
+}
+
+// resourceAgentBranchImport accepts IDs in the form <agent_id>/<branch_id>.
+func resourceAgentBranchImport(ctx context.Context, d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+	parts := strings.SplitN(d.Id(), "/", 2)
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		return nil, fmt.Errorf("unexpected import ID %q, expected <agent_id>/<branch_id>", d.Id())
+	}
+	d.Set("agent_id", parts[0])
+	d.SetId(parts[1])
+	return []*schema.ResourceData{d}, nil
+}
+
+// branchConversationConfigResource derives the schema of branch overrides
+// from the agent's conversation_config. Every setting is optional, has no
+// default and is computed, so settings inherited from the parent version are
+// read back without producing a diff. Blocks that hold a list of items keep
+// their item schema.
+func branchConversationConfigResource() *schema.Resource {
+	r := conversationConfigResource()
+	makeOverrideSchema(r.Schema)
+	return r
+}
+
+func makeOverrideSchema(s map[string]*schema.Schema) {
+	for _, attr := range s {
+		attr.Required = false
+		attr.Optional = true
+		attr.Computed = true
+		attr.Default = nil
+		if block, ok := attr.Elem.(*schema.Resource); ok && attr.MaxItems == 1 {
+			makeOverrideSchema(block.Schema)
+		}
+	}
+}
+
+// expandBranchConversationConfig returns the conversation_config overrides of
+// a branch. ResourceData holds the inherited values of the settings that are
+// not configured, so these are removed by checking the raw configuration.
+func expandBranchConversationConfig(d *schema.ResourceData) *ConversationConfig {
+	v, ok := d.Get("conversation_config").([]interface{})
+	if !ok || len(v) == 0 || v[0] == nil {
+		return nil
+	}
+	convConfig := expandConversationConfig(d, v[0].(map[string]interface{}))
+	pinPronunciationDictionaryLocators(d, convConfig)
+
+	config := rawConfigValue(d.GetRawConfig(), "conversation_config", 0)
+	unset := func(path ...interface{}) bool {
+		return rawConfigValue(config, path...).IsNull()
+	}
+
+	if unset("tts", 0) {
+		convConfig.TTS = nil
+	} else if tts := convConfig.TTS; tts != nil {
+		if unset("tts", 0, "voice_id") {
+			tts.VoiceID = ""
+		}
+		if unset("tts", 0, "stability") {
+			tts.Stability = nil
+		}
+		if unset("tts", 0, "speed") {
+			tts.Speed = nil
+		}
+		if unset("tts", 0, "similarity_boost") {
+			tts.SimilarityBoost = nil
+		}
+		if unset("tts", 0, "model_id") {
+			tts.ModelID = ""
+		}
+		if unset("tts", 0, "agent_output_audio_format") {
+			tts.AgentOutputAudioFormat = ""
+		}
+		if unset("tts", 0, "optimize_streaming_latency") {
+			tts.OptimizeStreamingLatency = nil
+		}
+		if unset("tts", 0, "supported_voices", 0) {
+			tts.SupportedVoices = nil
+		}
+		if unset("tts", 0, "pronunciation_dictionary_locators", 0) {
+			tts.PronunciationDictionaryLocators = nil
+		}
+	}
+
+	if unset("agent", 0) {
+		convConfig.Agent = nil
+	} else if agent := convConfig.Agent; agent != nil {
+		if unset("agent", 0, "first_message") {
+			agent.FirstMessage = ""
+		}
+		if unset("agent", 0, "language") {
+			agent.Language = ""
+		}
+		if unset("agent", 0, "prompt", 0) {
+			agent.Prompt = nil
+		} else if prompt := agent.Prompt; prompt != nil {
+			unsetPrompt := func(name string) bool {
+				return unset("agent", 0, "prompt", 0, name)
+			}
+			if unsetPrompt("prompt") {
+				prompt.Prompt = ""
+			}
+			if unsetPrompt("llm") {
+				prompt.LLM = ""
+			}
+			if unsetPrompt("tools") {
+				prompt.ToolIDs = nil
+			}
+			if unsetPrompt("temperature") {
+				prompt.Temperature = nil
+			}
+			if unsetPrompt("max_tokens") {
+				prompt.MaxTokens = nil
+			}
+			if unset("agent", 0, "prompt", 0, "knowledge_base", 0) {
+				prompt.KnowledgeBase = nil
+			}
+			if unset("agent", 0, "prompt", 0, "custom_llm", 0) {
+				prompt.CustomLLM = nil
+			}
+			if unset("agent", 0, "prompt", 0, "backup_llm_config", 0) {
+				prompt.BackupLLMConfig = nil
+			}
+			if unsetPrompt("cascade_timeout_seconds") {
+				prompt.CascadeTimeoutSeconds = nil
+			}
+			if unsetPrompt("reasoning_effort") {
+				prompt.ReasoningEffort = ""
+			}
+			if unsetPrompt("ignore_default_personality") {
+				prompt.IgnoreDefaultPersonality = nil
+			}
+		}
+	}
+
+	if unset("conversation", 0, "text_only") {
+		convConfig.Conversation = nil
+	}
+	if presets := rawConfigValue(config, "language_presets"); presets.IsNull() || (presets.IsKnown() && presets.LengthInt() == 0) {
+		convConfig.LanguagePresets = nil
+	}
+
+	return convConfig
+}
@@ -0,0 +1,162 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"math"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func resourceAgentDeployment() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceAgentDeploymentCreate,
+		ReadContext:   resourceAgentDeploymentRead,
+		UpdateContext: resourceAgentDeploymentUpdate,
+		DeleteContext: resourceAgentDeploymentDelete,
+		CustomizeDiff: resourceAgentDeploymentCustomizeDiff,
+		Importer: &schema.ResourceImporter{
+			StateContext: func(ctx context.Context, d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+				d.Set("agent_id", d.Id())
+				return []*schema.ResourceData{d}, nil
+			},
+		},
+		Description: "Splits an agent's live traffic across its branches. Destroying this resource leaves the last deployment in place.",
+		Schema: map[string]*schema.Schema{
+			"agent_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"traffic": {
+				Type:     schema.TypeSet,
+				Required: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"branch_id": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"percentage": {
+							Type:         schema.TypeFloat,
+							Required:     true,
+							Description:  "The share of conversations routed to the branch. It must be above 0.",
+							ValidateFunc: validation.FloatBetween(0, 100),
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceAgentDeploymentCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*Client)
+	agentID := d.Get("agent_id").(string)
+
+	err := client.DeployAgent(ctx, agentID, expandDeploymentRequests(d))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(agentID)
+	return resourceAgentDeploymentRead(ctx, d, m)
+}
+
+func resourceAgentDeploymentRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*Client)
+	agentID := d.Id()
+
+	branches, err := client.ListAgentBranches(ctx, agentID)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	// Only a missing agent returns nil; an agent without branches has an
+	// empty deployment.
+	if branches == nil {
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("agent_id", agentID)
+	traffic := make([]interface{}, 0, len(branches))
+	for _, branch := range branches {
+		if branch.CurrentLivePercentage <= 0 {
+			continue
+		}
+		traffic = append(traffic, map[string]interface{}{
+			"branch_id":  branch.ID,
+			"percentage": branch.CurrentLivePercentage,
+		})
+	}
+	if err := d.Set("traffic", traffic); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+func resourceAgentDeploymentUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*Client)
+
+	if d.HasChange("traffic") {
+		err := client.DeployAgent(ctx, d.Id(), expandDeploymentRequests(d))
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return resourceAgentDeploymentRead(ctx, d, m)
+}
+
+func resourceAgentDeploymentDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	// Traffic has to go somewhere, so the last deployment stays live.
+	d.SetId("")
+	return nil
+}
+
+func resourceAgentDeploymentCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, m interface{}) error {
+	if !d.NewValueKnown("traffic") {
+		return nil
+	}
+
+	total := 0.0
+	seen := make(map[string]bool)
+	for _, item := range d.Get("traffic").(*schema.Set).List() {
+		traffic := item.(map[string]interface{})
+		branchID := traffic["branch_id"].(string)
+		if seen[branchID] {
+			return fmt.Errorf("traffic: branch %q is listed more than once", branchID)
+		}
+		seen[branchID] = true
+		// Branches without live traffic are not listed by Read, so they
+		// would show up as a diff on every plan.
+		if traffic["percentage"].(float64) <= 0 {
+			return fmt.Errorf("traffic: branch %q has a percentage of 0, leave out branches that should not receive traffic", branchID)
+		}
+		total += traffic["percentage"].(float64)
+	}
+	if math.Abs(total-100) > 1e-6 {
+		return fmt.Errorf("traffic percentages must sum to 100, got %g", total)
+	}
+	return nil
+}
+
+func expandDeploymentRequests(d *schema.ResourceData) []*DeploymentRequest {
+	trafficList := d.Get("traffic").(*schema.Set).List()
+	requests := make([]*DeploymentRequest, len(trafficList))
+	for i, item := range trafficList {
+		traffic := item.(map[string]interface{})
+		requests[i] = &DeploymentRequest{
+			BranchID: traffic["branch_id"].(string),
+			DeploymentStrategy: &DeploymentStrategy{
+				Type:              "percentage",
+				TrafficPercentage: traffic["percentage"].(float64),
+			},
+		}
+	}
+	return requests
+}
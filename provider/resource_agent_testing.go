@@ -0,0 +1,297 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func resourceAgentTest() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceAgentTestCreate,
+		ReadContext:   resourceAgentTestRead,
+		UpdateContext: resourceAgentTestUpdate,
+		DeleteContext: resourceAgentTestDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		Schema: map[string]*schema.Schema{
+			"test_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"chat_history": {
+				Type:        schema.TypeList,
+				Required:    true,
+				Description: "The conversation leading up to the agent response under test.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"role": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringInSlice([]string{"user", "agent"}, false),
+						},
+						"message": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+					},
+				},
+			},
+			"success_condition": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "A description of the expected agent response, evaluated by an LLM.",
+			},
+			"success_examples": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"failure_examples": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"tool_call": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				MaxItems:    1,
+				Description: "Expect the agent to call a tool instead of, or in addition to, the success condition.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"tool_id": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"tool_type": {
+							Type:     schema.TypeString,
+							Optional: true,
+							Default:  "webhook",
+						},
+						"verify_absence": {
+							Type:        schema.TypeBool,
+							Optional:    true,
+							Description: "Pass only if the tool is not called.",
+						},
+						"parameter": {
+							Type:     schema.TypeList,
+							Optional: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"path": {
+										Type:     schema.TypeString,
+										Required: true,
+									},
+									"eval_type": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validation.StringInSlice([]string{"exact", "regex", "llm"}, false),
+									},
+									"value": {
+										Type:        schema.TypeString,
+										Required:    true,
+										Description: "The expected value, pattern or LLM description, depending on `eval_type`.",
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"dynamic_variables": {
+				Type:     schema.TypeMap,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+		},
+	}
+}
+
+func resourceAgentTestCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*Client)
+
+	createdTest, err := client.CreateAgentTest(ctx, expandAgentTest(d))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(createdTest.ID)
+	return resourceAgentTestRead(ctx, d, m)
+}
+
+func resourceAgentTestRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*Client)
+	testID := d.Id()
+
+	test, err := client.GetAgentTest(ctx, testID)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if test == nil {
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("test_id", testID)
+	d.Set("name", test.Name)
+	d.Set("success_condition", test.SuccessCondition)
+	if err := d.Set("dynamic_variables", test.DynamicVariables); err != nil {
+		return diag.FromErr(err)
+	}
+
+	chatHistory := make([]interface{}, len(test.ChatHistory))
+	for i, message := range test.ChatHistory {
+		chatHistory[i] = map[string]interface{}{
+			"role":    message.Role,
+			"message": message.Message,
+		}
+	}
+	if err := d.Set("chat_history", chatHistory); err != nil {
+		return diag.FromErr(err)
+	}
+
+	successExamples := make([]string, len(test.SuccessExamples))
+	for i, example := range test.SuccessExamples {
+		successExamples[i] = example.Response
+	}
+	if err := d.Set("success_examples", successExamples); err != nil {
+		return diag.FromErr(err)
+	}
+	failureExamples := make([]string, len(test.FailureExamples))
+	for i, example := range test.FailureExamples {
+		failureExamples[i] = example.Response
+	}
+	if err := d.Set("failure_examples", failureExamples); err != nil {
+		return diag.FromErr(err)
+	}
+
+	if test.ToolCallParameters != nil && test.ToolCallParameters.ReferencedTool != nil {
+		toolCall := make(map[string]interface{})
+		toolCall["tool_id"] = test.ToolCallParameters.ReferencedTool.ID
+		toolCall["tool_type"] = test.ToolCallParameters.ReferencedTool.Type
+		toolCall["verify_absence"] = test.ToolCallParameters.VerifyAbsence
+		parameters := make([]interface{}, len(test.ToolCallParameters.Parameters))
+		for i, param := range test.ToolCallParameters.Parameters {
+			paramMap := map[string]interface{}{"path": param.Path}
+			if param.Eval != nil {
+				paramMap["eval_type"] = param.Eval.Type
+				switch param.Eval.Type {
+				case "exact":
+					paramMap["value"] = param.Eval.ExpectedValue
+				case "regex":
+					paramMap["value"] = param.Eval.Pattern
+				case "llm":
+					paramMap["value"] = param.Eval.Description
+				}
+			}
+			parameters[i] = paramMap
+		}
+		toolCall["parameter"] = parameters
+		if err := d.Set("tool_call", []interface{}{toolCall}); err != nil {
+			return diag.FromErr(err)
+		}
+	} else {
+		d.Set("tool_call", nil)
+	}
+
+	return nil
+}
+
+func resourceAgentTestUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*Client)
+	testID := d.Id()
+
+	if d.HasChanges("name", "chat_history", "success_condition", "success_examples", "failure_examples", "tool_call", "dynamic_variables") {
+		err := client.UpdateAgentTest(ctx, testID, expandAgentTest(d))
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return resourceAgentTestRead(ctx, d, m)
+}
+
+func resourceAgentTestDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*Client)
+	testID := d.Id()
+
+	err := client.DeleteAgentTest(ctx, testID)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId("")
+	return nil
+}
+
+func expandAgentTest(d *schema.ResourceData) *AgentTest {
+	test := &AgentTest{
+		Name:             d.Get("name").(string),
+		Type:             "llm",
+		SuccessCondition: d.Get("success_condition").(string),
+		SuccessExamples:  []*AgentTestExample{},
+		FailureExamples:  []*AgentTestExample{},
+	}
+
+	for _, item := range d.Get("chat_history").([]interface{}) {
+		messageData := item.(map[string]interface{})
+		test.ChatHistory = append(test.ChatHistory, &AgentTestChatMessage{
+			Role:    messageData["role"].(string),
+			Message: messageData["message"].(string),
+		})
+	}
+
+	for _, example := range d.Get("success_examples").([]interface{}) {
+		test.SuccessExamples = append(test.SuccessExamples, &AgentTestExample{Response: example.(string), Type: "success"})
+	}
+	for _, example := range d.Get("failure_examples").([]interface{}) {
+		test.FailureExamples = append(test.FailureExamples, &AgentTestExample{Response: example.(string), Type: "failure"})
+	}
+
+	if v, ok := d.Get("dynamic_variables").(map[string]interface{}); ok && len(v) > 0 {
+		test.DynamicVariables = make(map[string]string)
+		for key, val := range v {
+			test.DynamicVariables[key] = val.(string)
+		}
+	}
+
+	if toolList, ok := d.Get("tool_call").([]interface{}); ok && len(toolList) > 0 && toolList[0] != nil {
+		toolData := toolList[0].(map[string]interface{})
+		toolCall := &AgentTestToolCallParameters{
+			ReferencedTool: &AgentTestReferencedTool{
+				ID:   toolData["tool_id"].(string),
+				Type: toolData["tool_type"].(string),
+			},
+			Parameters:    []*AgentTestToolCallParameter{},
+			VerifyAbsence: toolData["verify_absence"].(bool),
+		}
+		for _, item := range toolData["parameter"].([]interface{}) {
+			paramData := item.(map[string]interface{})
+			eval := &AgentTestParameterEvaluation{Type: paramData["eval_type"].(string)}
+			switch eval.Type {
+			case "exact":
+				eval.ExpectedValue = paramData["value"].(string)
+			case "regex":
+				eval.Pattern = paramData["value"].(string)
+			case "llm":
+				eval.Description = paramData["value"].(string)
+			}
+			toolCall.Parameters = append(toolCall.Parameters, &AgentTestToolCallParameter{
+				Path: paramData["path"].(string),
+				Eval: eval,
+			})
+		}
+		test.Type = "tool"
+		test.ToolCallParameters = toolCall
+	}
+
+	return test
+}
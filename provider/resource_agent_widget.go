@@ -0,0 +1,420 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+const widgetEmbedSnippet = `<elevenlabs-convai agent-id="%s"></elevenlabs-convai><script src="https://unpkg.com/@elevenlabs/convai-widget-embed" async type="text/javascript"></script>`
+
+func resourceAgentWidget() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceAgentWidgetCreate,
+		ReadContext:   resourceAgentWidgetRead,
+		UpdateContext: resourceAgentWidgetUpdate,
+		DeleteContext: resourceAgentWidgetDelete,
+		CustomizeDiff: resourceAgentWidgetCustomizeDiff,
+		Importer: &schema.ResourceImporter{
+			StateContext: func(ctx context.Context, d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+				d.Set("agent_id", d.Id())
+				return []*schema.ResourceData{d}, nil
+			},
+		},
+		Description: "Manages the embeddable widget of an agent. Destroying this resource leaves the widget settings on the agent unchanged.",
+		Schema: map[string]*schema.Schema{
+			"agent_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"variant": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.StringInSlice([]string{"tiny", "compact", "full", "expandable"}, false),
+			},
+			"expandable": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.StringInSlice([]string{"never", "mobile", "desktop", "always"}, false),
+			},
+			"feedback_mode": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.StringInSlice([]string{"none", "during", "end"}, false),
+			},
+			"avatar": {
+				Type:          schema.TypeList,
+				Optional:      true,
+				Computed:      true,
+				MaxItems:      1,
+				ConflictsWith: []string{"avatar_image_file"},
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"type": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringInSlice([]string{"orb", "url", "image"}, false),
+						},
+						"color_1": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"color_2": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"url": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "The avatar image URL for the `url` type.",
+						},
+					},
+				},
+			},
+			"avatar_image_file": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ConflictsWith: []string{"avatar"},
+				Description:   "Path to a local image uploaded as the widget avatar.",
+			},
+			"avatar_image_hash": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"bg_color": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"text_color": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"btn_color": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"btn_text_color": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"border_color": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"focus_color": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"border_radius": {
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
+			},
+			"btn_radius": {
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
+			},
+			"action_text": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"start_call_text": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"end_call_text": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"expand_text": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"listening_text": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"speaking_text": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"text_contents": {
+				Type:        schema.TypeMap,
+				Optional:    true,
+				Description: "Overrides for other widget texts, keyed by text identifier.",
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"terms_text": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"terms_html": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"terms_key": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Identifies the terms version; changing it asks users to accept the terms again.",
+			},
+			"show_avatar_when_collapsed": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Computed: true,
+			},
+			"disable_banner": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Computed: true,
+			},
+			"transcript_enabled": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Computed: true,
+			},
+			"text_input_enabled": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Computed: true,
+			},
+			"embed_snippet": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "HTML that embeds the widget in a web page.",
+			},
+		},
+	}
+}
+
+func resourceAgentWidgetCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	agentID := d.Get("agent_id").(string)
+	if diags := applyAgentWidget(ctx, d, m.(*Client), agentID); diags.HasError() {
+		return diags
+	}
+
+	d.SetId(agentID)
+	return resourceAgentWidgetRead(ctx, d, m)
+}
+
+func resourceAgentWidgetRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*Client)
+	agentID := d.Id()
+
+	agent, err := client.GetAgent(ctx, agentID)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if agent == nil {
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("agent_id", agentID)
+	d.Set("embed_snippet", fmt.Sprintf(widgetEmbedSnippet, agentID))
+
+	if agent.PlatformSettings == nil || agent.PlatformSettings.Widget == nil {
+		return nil
+	}
+	widget := agent.PlatformSettings.Widget
+
+	d.Set("variant", widget.Variant)
+	d.Set("expandable", widget.Expandable)
+	d.Set("feedback_mode", widget.FeedbackMode)
+	d.Set("bg_color", widget.BgColor)
+	d.Set("text_color", widget.TextColor)
+	d.Set("btn_color", widget.BtnColor)
+	d.Set("btn_text_color", widget.BtnTextColor)
+	d.Set("border_color", widget.BorderColor)
+	d.Set("focus_color", widget.FocusColor)
+	if widget.BorderRadius != nil {
+		d.Set("border_radius", *widget.BorderRadius)
+	}
+	if widget.BtnRadius != nil {
+		d.Set("btn_radius", *widget.BtnRadius)
+	}
+	d.Set("action_text", widget.ActionText)
+	d.Set("start_call_text", widget.StartCallText)
+	d.Set("end_call_text", widget.EndCallText)
+	d.Set("expand_text", widget.ExpandText)
+	d.Set("listening_text", widget.ListeningText)
+	d.Set("speaking_text", widget.SpeakingText)
+	d.Set("terms_text", widget.TermsText)
+	d.Set("terms_html", widget.TermsHTML)
+	d.Set("terms_key", widget.TermsKey)
+	var textContents map[string]string
+	if widget.TextContents != nil {
+		textContents = *widget.TextContents
+	}
+	if err := d.Set("text_contents", textContents); err != nil {
+		return diag.FromErr(err)
+	}
+	if widget.ShowAvatarWhenCollapsed != nil {
+		d.Set("show_avatar_when_collapsed", *widget.ShowAvatarWhenCollapsed)
+	}
+	if widget.DisableBanner != nil {
+		d.Set("disable_banner", *widget.DisableBanner)
+	}
+	if widget.TranscriptEnabled != nil {
+		d.Set("transcript_enabled", *widget.TranscriptEnabled)
+	}
+	if widget.TextInputEnabled != nil {
+		d.Set("text_input_enabled", *widget.TextInputEnabled)
+	}
+
+	// An uploaded image is tracked through avatar_image_file instead.
+	if widget.Avatar != nil && d.Get("avatar_image_file").(string) == "" {
+		avatar := map[string]interface{}{
+			"type":    widget.Avatar.Type,
+			"color_1": widget.Avatar.Color1,
+			"color_2": widget.Avatar.Color2,
+			"url":     widget.Avatar.CustomURL,
+		}
+		if err := d.Set("avatar", []interface{}{avatar}); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return nil
+}
+
+func resourceAgentWidgetUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	if diags := applyAgentWidget(ctx, d, m.(*Client), d.Id()); diags.HasError() {
+		return diags
+	}
+
+	return resourceAgentWidgetRead(ctx, d, m)
+}
+
+func resourceAgentWidgetDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	// The widget settings belong to the agent, which keeps them.
+	d.SetId("")
+	return nil
+}
+
+func resourceAgentWidgetCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, m interface{}) error {
+	hash := ""
+	if path, ok := d.GetOk("avatar_image_file"); ok {
+		var err error
+		hash, err = fileSHA256(path.(string))
+		if err != nil {
+			return err
+		}
+	}
+	if hash != d.Get("avatar_image_hash").(string) {
+		return d.SetNew("avatar_image_hash", hash)
+	}
+	return nil
+}
+
+func applyAgentWidget(ctx context.Context, d *schema.ResourceData, client *Client, agentID string) diag.Diagnostics {
+	if path, ok := d.GetOk("avatar_image_file"); ok && d.HasChange("avatar_image_hash") {
+		image, err := os.ReadFile(path.(string))
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		if _, err := client.UploadAgentAvatar(ctx, agentID, filepath.Base(path.(string)), image); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	agent := &Agent{
+		PlatformSettings: &PlatformSettings{Widget: expandWidgetConfig(d)},
+	}
+	if err := client.UpdateAgent(ctx, agentID, agent); err != nil {
+		return diag.FromErr(err)
+	}
+	return nil
+}
+
+func expandWidgetConfig(d *schema.ResourceData) *WidgetConfig {
+	widget := &WidgetConfig{
+		Variant:       d.Get("variant").(string),
+		Expandable:    d.Get("expandable").(string),
+		FeedbackMode:  d.Get("feedback_mode").(string),
+		BgColor:       d.Get("bg_color").(string),
+		TextColor:     d.Get("text_color").(string),
+		BtnColor:      d.Get("btn_color").(string),
+		BtnTextColor:  d.Get("btn_text_color").(string),
+		BorderColor:   d.Get("border_color").(string),
+		FocusColor:    d.Get("focus_color").(string),
+		ActionText:    d.Get("action_text").(string),
+		StartCallText: d.Get("start_call_text").(string),
+		EndCallText:   d.Get("end_call_text").(string),
+		ExpandText:    d.Get("expand_text").(string),
+		ListeningText: d.Get("listening_text").(string),
+		SpeakingText:  d.Get("speaking_text").(string),
+	}
+
+	// The terms and text overrides are not computed, so removing them from
+	// the configuration sends an empty value; leaving them out would keep
+	// them.
+	widget.TermsText = widgetString(d, "terms_text")
+	widget.TermsHTML = widgetString(d, "terms_html")
+	widget.TermsKey = widgetString(d, "terms_key")
+
+	if v, ok := d.GetOk("border_radius"); ok {
+		radius := v.(int)
+		widget.BorderRadius = &radius
+	}
+	if v, ok := d.GetOk("btn_radius"); ok {
+		radius := v.(int)
+		widget.BtnRadius = &radius
+	}
+	if v, ok := d.Get("text_contents").(map[string]interface{}); ok && (len(v) > 0 || d.HasChange("text_contents")) {
+		textContents := make(map[string]string)
+		for key, val := range v {
+			textContents[key] = val.(string)
+		}
+		widget.TextContents = &textContents
+	}
+
+	widget.ShowAvatarWhenCollapsed = rawConfigBool(d, "show_avatar_when_collapsed")
+	widget.DisableBanner = rawConfigBool(d, "disable_banner")
+	widget.TranscriptEnabled = rawConfigBool(d, "transcript_enabled")
+	widget.TextInputEnabled = rawConfigBool(d, "text_input_enabled")
+
+	if avatarList, ok := d.Get("avatar").([]interface{}); ok && len(avatarList) > 0 && avatarList[0] != nil && d.Get("avatar_image_file").(string) == "" {
+		avatarData := avatarList[0].(map[string]interface{})
+		widget.Avatar = &WidgetAvatar{
+			Type:      avatarData["type"].(string),
+			Color1:    avatarData["color_1"].(string),
+			Color2:    avatarData["color_2"].(string),
+			CustomURL: avatarData["url"].(string),
+		}
+	}
+
+	return widget
+}
+
+// widgetString returns the value of a string attribute of the widget, or nil
+// when it is neither set nor removed.
+func widgetString(d *schema.ResourceData, key string) *string {
+	v := d.Get(key).(string)
+	if v == "" && !d.HasChange(key) {
+		return nil
+	}
+	return &v
+}
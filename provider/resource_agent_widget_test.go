@@ -0,0 +1,91 @@
+package provider
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+// widgetTestData returns the ResourceData of an update from state to config.
+func widgetTestData(t *testing.T, state map[string]string, config map[string]interface{}) *schema.ResourceData {
+	t.Helper()
+	sm := schema.InternalMap(resourceAgentWidget().Schema)
+	instanceState := &terraform.InstanceState{ID: "agent", Attributes: state}
+	diff, err := sm.Diff(t.Context(), instanceState, terraform.NewResourceConfigRaw(config), nil, nil, true)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	d, err := sm.Data(instanceState, diff)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	return d
+}
+
+func TestExpandWidgetConfigRemovedFields(t *testing.T) {
+	state := map[string]string{
+		"id":                  "agent",
+		"agent_id":            "agent",
+		"terms_text":          "Terms",
+		"terms_html":          "<p>Terms</p>",
+		"terms_key":           "v1",
+		"text_contents.%":     "1",
+		"text_contents.hello": "Hi",
+	}
+
+	cases := []struct {
+		name string
+		key  string
+		want string
+	}{
+		{name: "terms_text", key: "terms_text", want: `""`},
+		{name: "terms_html", key: "terms_html", want: `""`},
+		{name: "terms_key", key: "terms_key", want: `""`},
+		{name: "text_contents", key: "text_contents", want: `{}`},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			config := map[string]interface{}{
+				"agent_id":      "agent",
+				"terms_text":    "Terms",
+				"terms_html":    "<p>Terms</p>",
+				"terms_key":     "v1",
+				"text_contents": map[string]interface{}{"hello": "Hi"},
+			}
+			delete(config, tc.key)
+
+			encoded, err := json.Marshal(expandWidgetConfig(widgetTestData(t, state, config)))
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+			var widget map[string]json.RawMessage
+			if err := json.Unmarshal(encoded, &widget); err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+			if got := string(widget[tc.key]); got != tc.want {
+				t.Fatalf("expected %s to be sent as %s, got %q", tc.key, tc.want, got)
+			}
+		})
+	}
+}
+
+func TestExpandWidgetConfigUnsetFields(t *testing.T) {
+	d := widgetTestData(t, map[string]string{"id": "agent", "agent_id": "agent"}, map[string]interface{}{"agent_id": "agent"})
+
+	encoded, err := json.Marshal(expandWidgetConfig(d))
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	var widget map[string]json.RawMessage
+	if err := json.Unmarshal(encoded, &widget); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	for _, key := range []string{"terms_text", "terms_html", "terms_key", "text_contents"} {
+		if v, ok := widget[key]; ok {
+			t.Errorf("expected %s to be left out, got %s", key, v)
+		}
+	}
+}
@@ -0,0 +1,323 @@
+package provider
+
+import (
+	"context"
+	"encoding/csv"
+	"fmt"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func resourceBatchCall() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceBatchCallCreate,
+		ReadContext:   resourceBatchCallRead,
+		UpdateContext: resourceBatchCallUpdate,
+		DeleteContext: resourceBatchCallDelete,
+		CustomizeDiff: resourceBatchCallCustomizeDiff,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(60 * time.Minute),
+		},
+		Description: "Schedules a batch of outbound calls, and cancels it on destroy. The recipients and the scheduled time are not read back from the API, so after `terraform import` the next plan would replace the batch and place the calls again; list `recipient`, `recipients_file` and `scheduled_time` in `lifecycle.ignore_changes` of an imported batch.",
+		Schema: map[string]*schema.Schema{
+			"batch_call_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"agent_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"phone_number_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The ID of the agent phone number the calls are placed from.",
+			},
+			"scheduled_time": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Description:  "When to start calling, as an RFC 3339 timestamp. Calls start immediately when omitted.",
+				ValidateFunc: validation.IsRFC3339Time,
+			},
+			"recipient": {
+				Type:         schema.TypeList,
+				Optional:     true,
+				ForceNew:     true,
+				ExactlyOneOf: []string{"recipient", "recipients_file"},
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"phone_number": {
+							Type:     schema.TypeString,
+							Required: true,
+							ForceNew: true,
+						},
+						"dynamic_variables": {
+							Type:     schema.TypeMap,
+							Optional: true,
+							ForceNew: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+					},
+				},
+			},
+			"recipients_file": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ExactlyOneOf: []string{"recipient", "recipients_file"},
+				Description:  "Path to a local CSV file with a header row. The `phone_number` column is required; every other column is passed to the agent as a dynamic variable.",
+			},
+			"recipients_file_hash": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"wait_for_completion": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Wait until every call in the batch has finished before completing the apply.",
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"total_calls_scheduled": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"total_calls_dispatched": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"created_at_unix": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"last_updated_at_unix": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceBatchCallCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*Client)
+
+	recipients, err := expandBatchCallRecipients(d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	batch := &BatchCallRequest{
+		CallName:           d.Get("name").(string),
+		AgentID:            d.Get("agent_id").(string),
+		AgentPhoneNumberID: d.Get("phone_number_id").(string),
+		Recipients:         recipients,
+	}
+	if v, ok := d.GetOk("scheduled_time"); ok {
+		scheduledTime, err := time.Parse(time.RFC3339, v.(string))
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		unix := scheduledTime.Unix()
+		batch.ScheduledTimeUnix = &unix
+	}
+
+	createdBatch, err := client.CreateBatchCall(ctx, batch)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(createdBatch.ID)
+
+	if d.Get("wait_for_completion").(bool) {
+		stateConf := &retry.StateChangeConf{
+			Pending: []string{"pending", "in_progress"},
+			Target:  []string{"completed"},
+			Refresh: func() (interface{}, string, error) {
+				batch, err := client.GetBatchCall(ctx, d.Id())
+				if err != nil {
+					return nil, "", err
+				}
+				if batch == nil {
+					return nil, "", fmt.Errorf("batch call %s not found", d.Id())
+				}
+				if batch.Status == "failed" || batch.Status == "cancelled" {
+					return batch, batch.Status, fmt.Errorf("batch call %s finished with status %q", d.Id(), batch.Status)
+				}
+				return batch, batch.Status, nil
+			},
+			Timeout:    d.Timeout(schema.TimeoutCreate),
+			Delay:      10 * time.Second,
+			MinTimeout: 10 * time.Second,
+		}
+		if _, err := stateConf.WaitForStateContext(ctx); err != nil {
+			return append(resourceBatchCallRead(ctx, d, m), diag.FromErr(err)...)
+		}
+	}
+
+	return resourceBatchCallRead(ctx, d, m)
+}
+
+func resourceBatchCallRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*Client)
+	batchID := d.Id()
+
+	batch, err := client.GetBatchCall(ctx, batchID)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if batch == nil {
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("batch_call_id", batch.ID)
+	d.Set("name", batch.Name)
+	d.Set("agent_id", batch.AgentID)
+	d.Set("phone_number_id", batch.PhoneNumberID)
+	d.Set("status", batch.Status)
+	d.Set("total_calls_scheduled", batch.TotalCallsScheduled)
+	d.Set("total_calls_dispatched", batch.TotalCallsDispatched)
+	d.Set("created_at_unix", batch.CreatedAtUnix)
+	d.Set("last_updated_at_unix", batch.LastUpdatedAtUnix)
+
+	return nil
+}
+
+func resourceBatchCallUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	// Everything sent to the API forces a new batch; only provider-side
+	// settings such as wait_for_completion can change in place.
+	return resourceBatchCallRead(ctx, d, m)
+}
+
+func resourceBatchCallDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*Client)
+	batchID := d.Id()
+
+	batch, err := client.GetBatchCall(ctx, batchID)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if batch != nil && (batch.Status == "pending" || batch.Status == "in_progress") {
+		if err := client.CancelBatchCall(ctx, batchID); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	d.SetId("")
+	return nil
+}
+
+func resourceBatchCallCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, m interface{}) error {
+	hash := ""
+	if path, ok := d.GetOk("recipients_file"); ok {
+		var err error
+		hash, err = fileSHA256(path.(string))
+		if err != nil {
+			return err
+		}
+	}
+	if hash == d.Get("recipients_file_hash").(string) {
+		return nil
+	}
+	if err := d.SetNew("recipients_file_hash", hash); err != nil {
+		return err
+	}
+	if d.Id() != "" {
+		return d.ForceNew("recipients_file_hash")
+	}
+	return nil
+}
+
+func expandBatchCallRecipients(d *schema.ResourceData) ([]*BatchCallRecipient, error) {
+	if path, ok := d.GetOk("recipients_file"); ok {
+		return parseBatchCallRecipientsFile(path.(string))
+	}
+
+	var recipients []*BatchCallRecipient
+	for _, item := range d.Get("recipient").([]interface{}) {
+		recipientData := item.(map[string]interface{})
+		recipient := &BatchCallRecipient{
+			PhoneNumber: recipientData["phone_number"].(string),
+		}
+		if v, ok := recipientData["dynamic_variables"].(map[string]interface{}); ok && len(v) > 0 {
+			vars := make(map[string]string)
+			for key, val := range v {
+				vars[key] = val.(string)
+			}
+			recipient.ConversationInitiationClientData = &ConversationInitiationClientData{DynamicVariables: vars}
+		}
+		recipients = append(recipients, recipient)
+	}
+	return recipients, nil
+}
+
+func parseBatchCallRecipientsFile(path string) ([]*BatchCallRecipient, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	records, err := csv.NewReader(f).ReadAll()
+	if err != nil {
+		return nil, fmt.Errorf("reading %s: %w", path, err)
+	}
+	if len(records) < 2 {
+		return nil, fmt.Errorf("%s must contain a header row and at least one recipient", path)
+	}
+
+	header := records[0]
+	phoneColumn := -1
+	for i, column := range header {
+		header[i] = strings.TrimSpace(column)
+		if header[i] == "phone_number" {
+			phoneColumn = i
+		}
+	}
+	if phoneColumn == -1 {
+		return nil, fmt.Errorf("%s has no phone_number column", path)
+	}
+
+	recipients := make([]*BatchCallRecipient, 0, len(records)-1)
+	for line, record := range records[1:] {
+		phoneNumber := strings.TrimSpace(record[phoneColumn])
+		if phoneNumber == "" {
+			return nil, fmt.Errorf("%s: row %d has no phone number", path, line+2)
+		}
+		recipient := &BatchCallRecipient{PhoneNumber: phoneNumber}
+		vars := make(map[string]string)
+		for i, value := range record {
+			if i == phoneColumn || header[i] == "" || value == "" {
+				continue
+			}
+			vars[header[i]] = value
+		}
+		if len(vars) > 0 {
+			recipient.ConversationInitiationClientData = &ConversationInitiationClientData{DynamicVariables: vars}
+		}
+		recipients = append(recipients, recipient)
+	}
+	return recipients, nil
+}
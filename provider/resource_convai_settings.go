@@ -0,0 +1,184 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// convAISettingsID is the ID of the single elevenlabs_convai_settings
+// resource a workspace can have.
+const convAISettingsID = "convai_settings"
+
+func resourceConvAISettings() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceConvAISettingsCreate,
+		ReadContext:   resourceConvAISettingsRead,
+		UpdateContext: resourceConvAISettingsUpdate,
+		DeleteContext: resourceConvAISettingsDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: func(ctx context.Context, d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+				d.SetId(convAISettingsID)
+				return []*schema.ResourceData{d}, nil
+			},
+		},
+		Description: "Manages the conversational AI settings of the workspace. Destroying this resource only removes it from the state; the settings are left as they are.",
+		Schema: map[string]*schema.Schema{
+			"conversation_initiation_webhook": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				MaxItems:    1,
+				Description: "The webhook called to fetch conversation initiation data for every agent in the workspace.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"url": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"request_headers": {
+							Type:     schema.TypeMap,
+							Optional: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+					},
+				},
+			},
+			"post_call_webhook_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"send_audio": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Computed:    true,
+				Description: "Whether the post-call webhook also receives the call audio.",
+			},
+			"can_use_mcp_servers": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Computed: true,
+			},
+			"rag_retention_period_days": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.IntBetween(1, 30),
+			},
+			"default_livekit_stack": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.StringInSlice([]string{"standard", "static"}, false),
+			},
+		},
+	}
+}
+
+func resourceConvAISettingsCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*Client)
+
+	err := client.UpdateConvAISettings(ctx, expandConvAISettings(d))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(convAISettingsID)
+	return resourceConvAISettingsRead(ctx, d, m)
+}
+
+func resourceConvAISettingsRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*Client)
+
+	settings, err := client.GetConvAISettings(ctx)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if webhook := settings.ConversationInitiationClientDataWebhook; webhook != nil && webhook.URL != "" {
+		webhookMap := map[string]interface{}{
+			"url":             webhook.URL,
+			"request_headers": webhook.RequestHeaders,
+		}
+		if err := d.Set("conversation_initiation_webhook", []interface{}{webhookMap}); err != nil {
+			return diag.FromErr(err)
+		}
+	} else {
+		d.Set("conversation_initiation_webhook", nil)
+	}
+	if settings.Webhooks != nil {
+		d.Set("post_call_webhook_id", settings.Webhooks.PostCallWebhookID)
+		if settings.Webhooks.SendAudio != nil {
+			d.Set("send_audio", *settings.Webhooks.SendAudio)
+		}
+	}
+	if settings.CanUseMCPServers != nil {
+		d.Set("can_use_mcp_servers", *settings.CanUseMCPServers)
+	}
+	if settings.RAGRetentionPeriodDays != nil {
+		d.Set("rag_retention_period_days", *settings.RAGRetentionPeriodDays)
+	}
+	d.Set("default_livekit_stack", settings.DefaultLivekitStack)
+
+	return nil
+}
+
+func resourceConvAISettingsUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*Client)
+
+	if d.HasChanges("conversation_initiation_webhook", "post_call_webhook_id", "send_audio", "can_use_mcp_servers", "rag_retention_period_days", "default_livekit_stack") {
+		err := client.UpdateConvAISettings(ctx, expandConvAISettings(d))
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return resourceConvAISettingsRead(ctx, d, m)
+}
+
+func resourceConvAISettingsDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	// Workspace settings cannot be deleted, and resetting them would affect
+	// every agent, so only forget about them.
+	d.SetId("")
+	return nil
+}
+
+func expandConvAISettings(d *schema.ResourceData) *ConvAISettings {
+	settings := &ConvAISettings{
+		DefaultLivekitStack: d.Get("default_livekit_stack").(string),
+	}
+
+	if webhookList, ok := d.Get("conversation_initiation_webhook").([]interface{}); ok && len(webhookList) > 0 && webhookList[0] != nil {
+		webhookData := webhookList[0].(map[string]interface{})
+		webhook := &ConversationInitiationWebhook{
+			URL: webhookData["url"].(string),
+		}
+		if headers, ok := webhookData["request_headers"].(map[string]interface{}); ok && len(headers) > 0 {
+			webhook.RequestHeaders = make(map[string]string)
+			for key, val := range headers {
+				webhook.RequestHeaders[key] = val.(string)
+			}
+		}
+		settings.ConversationInitiationClientDataWebhook = webhook
+	} else if d.HasChange("conversation_initiation_webhook") {
+		// An empty webhook removes it; leaving it out would keep it.
+		settings.ConversationInitiationClientDataWebhook = &ConversationInitiationWebhook{}
+	}
+
+	// The bools are only sent when configured, so that settings made
+	// outside Terraform are not turned off.
+	settings.Webhooks = &ConvAIWebhooks{
+		PostCallWebhookID: d.Get("post_call_webhook_id").(string),
+		SendAudio:         rawConfigBool(d, "send_audio"),
+	}
+	settings.CanUseMCPServers = rawConfigBool(d, "can_use_mcp_servers")
+
+	if v, ok := d.GetOk("rag_retention_period_days"); ok {
+		days := v.(int)
+		settings.RAGRetentionPeriodDays = &days
+	}
+
+	return settings
+}
@@ -0,0 +1,124 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func resourceOutboundCall() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceOutboundCallCreate,
+		ReadContext:   resourceOutboundCallRead,
+		DeleteContext: resourceOutboundCallDelete,
+		Description:   "Places an outbound call from an agent, for example as a smoke check after a deployment. The call is placed when the resource is created; change any argument or `triggers` to place another one. Destroying this resource only removes it from the state.",
+		Schema: map[string]*schema.Schema{
+			"agent_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"agent_phone_number_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The ID of the phone number the call is placed from.",
+			},
+			"to_number": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The number to call, in E.164 format.",
+			},
+			"telephony_provider": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Default:      "twilio",
+				Description:  "The provider of the phone number, either `twilio` or `sip_trunk`.",
+				ValidateFunc: validation.StringInSlice([]string{"twilio", "sip_trunk"}, false),
+			},
+			"dynamic_variables": {
+				Type:        schema.TypeMap,
+				Optional:    true,
+				ForceNew:    true,
+				Description: "The dynamic variables passed to the agent for this call.",
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"triggers": {
+				Type:        schema.TypeMap,
+				Optional:    true,
+				ForceNew:    true,
+				Description: "Arbitrary values that place a new call when changed, such as the ID of an `elevenlabs_agent_deployment`.",
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"conversation_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"call_sid": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The Twilio call SID, or the SIP call ID for SIP trunk numbers.",
+			},
+		},
+	}
+}
+
+func resourceOutboundCallCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*Client)
+
+	call := &OutboundCallRequest{
+		AgentID:            d.Get("agent_id").(string),
+		AgentPhoneNumberID: d.Get("agent_phone_number_id").(string),
+		ToNumber:           d.Get("to_number").(string),
+	}
+	if variables, ok := d.Get("dynamic_variables").(map[string]interface{}); ok && len(variables) > 0 {
+		call.ConversationInitiationClientData = &ConversationInitiationClientData{
+			DynamicVariables: make(map[string]string),
+		}
+		for key, val := range variables {
+			call.ConversationInitiationClientData.DynamicVariables[key] = val.(string)
+		}
+	}
+
+	telephony := d.Get("telephony_provider").(string)
+	result, err := client.CreateOutboundCall(ctx, telephony, call)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	callSID := result.CallSID
+	if telephony == "sip_trunk" {
+		callSID = result.SIPCallID
+	}
+
+	// The conversation ID can be missing when the call is still being set
+	// up, so fall back to the call SID.
+	id := result.ConversationID
+	if id == "" {
+		id = callSID
+	}
+	if id == "" {
+		return diag.Errorf("the call was accepted but the API returned neither a conversation ID nor a call ID")
+	}
+
+	d.SetId(id)
+	d.Set("conversation_id", result.ConversationID)
+	d.Set("call_sid", callSID)
+
+	return nil
+}
+
+func resourceOutboundCallRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	// A placed call is not something to reconcile, so the state is kept as
+	// it was recorded at creation.
+	return nil
+}
+
+func resourceOutboundCallDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	d.SetId("")
+	return nil
+}
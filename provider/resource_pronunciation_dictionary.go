@@ -0,0 +1,380 @@
+package provider
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/xml"
+	"fmt"
+	"os"
+	"reflect"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func resourcePronunciationDictionary() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourcePronunciationDictionaryCreate,
+		ReadContext:   resourcePronunciationDictionaryRead,
+		UpdateContext: resourcePronunciationDictionaryUpdate,
+		DeleteContext: resourcePronunciationDictionaryDelete,
+		CustomizeDiff: resourcePronunciationDictionaryCustomizeDiff,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		Schema: map[string]*schema.Schema{
+			"pronunciation_dictionary_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"rule": {
+				Type:         schema.TypeList,
+				Optional:     true,
+				ExactlyOneOf: []string{"rule", "pls_file"},
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"string_to_replace": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"type": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringInSlice([]string{"alias", "phoneme"}, false),
+						},
+						"alias": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"phoneme": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"alphabet": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.StringInSlice([]string{"ipa", "cmu-arpabet"}, false),
+						},
+					},
+				},
+			},
+			"pls_file": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ExactlyOneOf: []string{"rule", "pls_file"},
+				Description:  "Path to a local W3C Pronunciation Lexicon Specification (.pls) file. Its lexemes are converted into dictionary rules.",
+			},
+			"pls_file_hash": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"version_id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The latest version of the dictionary. A new version is created whenever the rules change.",
+			},
+			"version_rules_num": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourcePronunciationDictionaryCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*Client)
+
+	rules, err := expandPronunciationDictionaryRules(d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	dict := &PronunciationDictionaryRequest{
+		Name:        d.Get("name").(string),
+		Description: d.Get("description").(string),
+		Rules:       rules,
+	}
+
+	version, err := client.CreatePronunciationDictionary(ctx, dict)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(version.ID)
+	return resourcePronunciationDictionaryRead(ctx, d, m)
+}
+
+func resourcePronunciationDictionaryRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*Client)
+	dictionaryID := d.Id()
+
+	dict, err := client.GetPronunciationDictionary(ctx, dictionaryID)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if dict == nil {
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("pronunciation_dictionary_id", dict.ID)
+	d.Set("name", dict.Name)
+	d.Set("description", dict.Description)
+	d.Set("version_id", dict.LatestVersionID)
+	d.Set("version_rules_num", dict.LatestVersionRulesNum)
+
+	if err := setPronunciationDictionaryRules(ctx, client, d, dict); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+// setPronunciationDictionaryRules reads the rules of the latest version back
+// so that changes made outside Terraform show up in the plan. Dictionaries
+// managed from a .pls file are compared with the file instead, and a mismatch
+// clears pls_file_hash so that the next plan uploads the file again.
+func setPronunciationDictionaryRules(ctx context.Context, client *Client, d *schema.ResourceData, dict *PronunciationDictionary) error {
+	if dict.LatestVersionID == "" {
+		return nil
+	}
+
+	content, err := client.DownloadPronunciationDictionaryVersion(ctx, dict.ID, dict.LatestVersionID)
+	if err != nil {
+		return err
+	}
+	if content == nil {
+		return nil
+	}
+	rules, err := parsePLS(content)
+	if err != nil {
+		return fmt.Errorf("version %s is not a valid PLS document: %w", dict.LatestVersionID, err)
+	}
+
+	if path, ok := d.GetOk("pls_file"); ok {
+		// A missing or invalid file is reported during plan, it should not
+		// fail the refresh.
+		fileRules, err := parsePLSFile(path.(string))
+		if err == nil && !reflect.DeepEqual(rules, fileRules) {
+			d.Set("pls_file_hash", "")
+		}
+		return nil
+	}
+
+	ruleList := make([]interface{}, len(rules))
+	for i, rule := range rules {
+		ruleList[i] = map[string]interface{}{
+			"string_to_replace": rule.StringToReplace,
+			"type":              rule.Type,
+			"alias":             rule.Alias,
+			"phoneme":           rule.Phoneme,
+			"alphabet":          rule.Alphabet,
+		}
+	}
+	return d.Set("rule", ruleList)
+}
+
+func resourcePronunciationDictionaryUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*Client)
+	dictionaryID := d.Id()
+
+	if d.HasChange("name") || d.HasChange("description") {
+		if err := client.UpdatePronunciationDictionary(ctx, dictionaryID, d.Get("name").(string), d.Get("description").(string)); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	if d.HasChange("rule") || d.HasChange("pls_file") || d.HasChange("pls_file_hash") {
+		rules, err := expandPronunciationDictionaryRules(d)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		if _, err := client.SetPronunciationDictionaryRules(ctx, dictionaryID, rules); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return resourcePronunciationDictionaryRead(ctx, d, m)
+}
+
+func resourcePronunciationDictionaryDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*Client)
+	dictionaryID := d.Id()
+
+	err := client.ArchivePronunciationDictionary(ctx, dictionaryID)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId("")
+	return nil
+}
+
+func resourcePronunciationDictionaryCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, m interface{}) error {
+	// Track the file contents so that edits to the .pls file produce a diff
+	// even though its path stays the same.
+	hash := ""
+	if path, ok := d.GetOk("pls_file"); ok {
+		var err error
+		hash, err = fileSHA256(path.(string))
+		if err != nil {
+			return err
+		}
+	}
+	if hash != d.Get("pls_file_hash").(string) {
+		if err := d.SetNew("pls_file_hash", hash); err != nil {
+			return err
+		}
+	}
+
+	if d.NewValueKnown("rule") {
+		for i, item := range d.Get("rule").([]interface{}) {
+			rule := item.(map[string]interface{})
+			switch rule["type"].(string) {
+			case "alias":
+				if rule["alias"].(string) == "" {
+					return fmt.Errorf("rule.%d: alias is required for alias rules", i)
+				}
+			case "phoneme":
+				if rule["phoneme"].(string) == "" || rule["alphabet"].(string) == "" {
+					return fmt.Errorf("rule.%d: phoneme and alphabet are required for phoneme rules", i)
+				}
+			}
+		}
+	}
+
+	// Changing the rules creates a new dictionary version, so let dependent
+	// agents know the version is about to change.
+	if d.Id() != "" && (d.HasChange("rule") || d.HasChange("pls_file_hash")) {
+		if err := d.SetNewComputed("version_id"); err != nil {
+			return err
+		}
+		if err := d.SetNewComputed("version_rules_num"); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func expandPronunciationDictionaryRules(d *schema.ResourceData) ([]*PronunciationDictionaryRule, error) {
+	if path, ok := d.GetOk("pls_file"); ok {
+		return parsePLSFile(path.(string))
+	}
+
+	ruleList := d.Get("rule").([]interface{})
+	rules := make([]*PronunciationDictionaryRule, len(ruleList))
+	for i, item := range ruleList {
+		ruleData := item.(map[string]interface{})
+		rules[i] = &PronunciationDictionaryRule{
+			StringToReplace: ruleData["string_to_replace"].(string),
+			Type:            ruleData["type"].(string),
+			Alias:           ruleData["alias"].(string),
+			Phoneme:         ruleData["phoneme"].(string),
+			Alphabet:        ruleData["alphabet"].(string),
+		}
+	}
+	return rules, nil
+}
+
+type plsLexicon struct {
+	Alphabet string      `xml:"alphabet,attr"`
+	Lexemes  []plsLexeme `xml:"lexeme"`
+}
+
+type plsLexeme struct {
+	Graphemes []string   `xml:"grapheme"`
+	Phoneme   plsPhoneme `xml:"phoneme"`
+	Alias     string     `xml:"alias"`
+}
+
+type plsPhoneme struct {
+	Alphabet string `xml:"alphabet,attr"`
+	Value    string `xml:",chardata"`
+}
+
+// parsePLSFile converts the lexemes of a PLS file into dictionary rules.
+func parsePLSFile(path string) ([]*PronunciationDictionaryRule, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	rules, err := parsePLS(data)
+	if err != nil {
+		return nil, fmt.Errorf("%s is not a valid PLS document: %w", path, err)
+	}
+	if len(rules) == 0 {
+		return nil, fmt.Errorf("%s does not contain any lexemes", path)
+	}
+	return rules, nil
+}
+
+// parsePLS converts the lexemes of a PLS document into dictionary rules,
+// producing one rule per grapheme.
+func parsePLS(data []byte) ([]*PronunciationDictionaryRule, error) {
+	var lexicon plsLexicon
+	if err := xml.Unmarshal(data, &lexicon); err != nil {
+		return nil, err
+	}
+
+	var rules []*PronunciationDictionaryRule
+	for _, lexeme := range lexicon.Lexemes {
+		for _, grapheme := range lexeme.Graphemes {
+			rule := &PronunciationDictionaryRule{
+				StringToReplace: strings.TrimSpace(grapheme),
+			}
+			if alias := strings.TrimSpace(lexeme.Alias); alias != "" {
+				rule.Type = "alias"
+				rule.Alias = alias
+			} else {
+				alphabet := lexeme.Phoneme.Alphabet
+				if alphabet == "" {
+					alphabet = lexicon.Alphabet
+				}
+				alphabet, err := plsAlphabet(alphabet)
+				if err != nil {
+					return nil, err
+				}
+				rule.Type = "phoneme"
+				rule.Phoneme = strings.TrimSpace(lexeme.Phoneme.Value)
+				rule.Alphabet = alphabet
+			}
+			rules = append(rules, rule)
+		}
+	}
+	return rules, nil
+}
+
+// plsAlphabet maps a PLS alphabet name to the one used by dictionary rules.
+func plsAlphabet(alphabet string) (string, error) {
+	switch strings.ToLower(alphabet) {
+	case "ipa", "":
+		return "ipa", nil
+	case "cmu-arpabet", "x-cmu", "cmu":
+		return "cmu-arpabet", nil
+	default:
+		return "", fmt.Errorf("unsupported PLS alphabet %q", alphabet)
+	}
+}
+
+func fileSHA256(path string) (string, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return "", err
+	}
+	sum := sha256.Sum256(data)
+	return hex.EncodeToString(sum[:]), nil
+}
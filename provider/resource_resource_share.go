@@ -0,0 +1,192 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// workspaceResourceTypes maps the resource types accepted by
+// elevenlabs_resource_share to their names in the workspace API.
+var workspaceResourceTypes = map[string]string{
+	"agent":                   "convai_agents",
+	"tool":                    "convai_tools",
+	"knowledge_base_document": "convai_knowledge_base_documents",
+	"voice":                   "voice",
+}
+
+func resourceResourceShare() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceResourceShareCreate,
+		ReadContext:   resourceResourceShareRead,
+		UpdateContext: resourceResourceShareUpdate,
+		DeleteContext: resourceResourceShareDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceResourceShareImport,
+		},
+		Schema: map[string]*schema.Schema{
+			"resource_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"resource_type": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice([]string{"agent", "tool", "knowledge_base_document", "voice"}, false),
+			},
+			"group_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ExactlyOneOf: []string{"group_id", "user_email"},
+			},
+			"user_email": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ExactlyOneOf: []string{"group_id", "user_email"},
+			},
+			"role": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringInSlice([]string{"viewer", "editor", "admin"}, false),
+			},
+		},
+	}
+}
+
+func resourceResourceShareCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*Client)
+	resourceID := d.Get("resource_id").(string)
+
+	err := client.ShareWorkspaceResource(ctx, resourceID, expandResourceShareRequest(d, d.Get("role").(string)))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	principal := "group/" + d.Get("group_id").(string)
+	if email := d.Get("user_email").(string); email != "" {
+		principal = "user/" + email
+	}
+	d.SetId(fmt.Sprintf("%s/%s/%s", d.Get("resource_type").(string), resourceID, principal))
+	return resourceResourceShareRead(ctx, d, m)
+}
+
+func resourceResourceShareRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*Client)
+	resourceType := d.Get("resource_type").(string)
+	resourceID := d.Get("resource_id").(string)
+
+	resource, err := client.GetWorkspaceResource(ctx, workspaceResourceTypes[resourceType], resourceID)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if resource == nil {
+		d.SetId("")
+		return nil
+	}
+
+	principalID := d.Get("group_id").(string)
+	if email := d.Get("user_email").(string); email != "" {
+		principalID = ""
+		for _, option := range resource.ShareOptions {
+			if option.Type == "user" && strings.EqualFold(option.Name, email) {
+				principalID = option.ID
+				break
+			}
+		}
+		if principalID == "" {
+			// The user no longer has a share on the resource.
+			d.SetId("")
+			return nil
+		}
+	}
+
+	role := ""
+	for _, candidate := range []string{"admin", "editor", "viewer"} {
+		for _, groupID := range resource.RoleToGroupIDs[candidate] {
+			if groupID == principalID {
+				role = candidate
+				break
+			}
+		}
+		if role != "" {
+			break
+		}
+	}
+
+	if role == "" {
+		// The share was removed outside Terraform.
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("role", role)
+	return nil
+}
+
+func resourceResourceShareUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*Client)
+
+	if d.HasChange("role") {
+		err := client.ShareWorkspaceResource(ctx, d.Get("resource_id").(string), expandResourceShareRequest(d, d.Get("role").(string)))
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return resourceResourceShareRead(ctx, d, m)
+}
+
+func resourceResourceShareDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*Client)
+
+	err := client.UnshareWorkspaceResource(ctx, d.Get("resource_id").(string), expandResourceShareRequest(d, ""))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId("")
+	return nil
+}
+
+// resourceResourceShareImport accepts IDs in the form
+// <resource_type>/<resource_id>/group/<group_id> or
+// <resource_type>/<resource_id>/user/<user_email>.
+func resourceResourceShareImport(ctx context.Context, d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+	parts := strings.SplitN(d.Id(), "/", 4)
+	if len(parts) != 4 || parts[1] == "" || parts[3] == "" {
+		return nil, fmt.Errorf("unexpected import ID %q, expected <resource_type>/<resource_id>/group/<group_id> or <resource_type>/<resource_id>/user/<user_email>", d.Id())
+	}
+	if _, ok := workspaceResourceTypes[parts[0]]; !ok {
+		return nil, fmt.Errorf("unexpected resource type %q in import ID", parts[0])
+	}
+
+	d.Set("resource_type", parts[0])
+	d.Set("resource_id", parts[1])
+	switch parts[2] {
+	case "group":
+		d.Set("group_id", parts[3])
+	case "user":
+		d.Set("user_email", parts[3])
+	default:
+		return nil, fmt.Errorf("unexpected principal type %q in import ID, expected group or user", parts[2])
+	}
+	return []*schema.ResourceData{d}, nil
+}
+
+func expandResourceShareRequest(d *schema.ResourceData, role string) *WorkspaceResourceShareRequest {
+	return &WorkspaceResourceShareRequest{
+		Role:         role,
+		ResourceType: workspaceResourceTypes[d.Get("resource_type").(string)],
+		UserEmail:    d.Get("user_email").(string),
+		GroupID:      d.Get("group_id").(string),
+	}
+}
@@ -0,0 +1,174 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func resourceServiceAccountAPIKey() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceServiceAccountAPIKeyCreate,
+		ReadContext:   resourceServiceAccountAPIKeyRead,
+		UpdateContext: resourceServiceAccountAPIKeyUpdate,
+		DeleteContext: resourceServiceAccountAPIKeyDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceServiceAccountAPIKeyImport,
+		},
+		Schema: map[string]*schema.Schema{
+			"service_account_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"key_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"permissions": {
+				Type:        schema.TypeSet,
+				Required:    true,
+				Description: "The scopes granted to the key, such as `text_to_speech` or `elevenlabs_agents_write`. Use `[\"all\"]` to grant every permission.",
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"character_limit": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Description:  "The maximum number of characters the key may consume per billing period. Unlimited when omitted.",
+				ValidateFunc: validation.IntAtLeast(0),
+			},
+			"enabled": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+			"hint": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"character_count": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"api_key": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Sensitive:   true,
+				Description: "The key value. It is only returned when the key is created and is empty for imported keys.",
+			},
+		},
+	}
+}
+
+func resourceServiceAccountAPIKeyCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*Client)
+	serviceAccountID := d.Get("service_account_id").(string)
+
+	createdKey, err := client.CreateServiceAccountAPIKey(ctx, serviceAccountID, expandServiceAccountAPIKey(d))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(createdKey.KeyID)
+	d.Set("api_key", createdKey.APIKey)
+
+	// Keys are always created enabled.
+	if !d.Get("enabled").(bool) {
+		if err := client.UpdateServiceAccountAPIKey(ctx, serviceAccountID, createdKey.KeyID, expandServiceAccountAPIKey(d)); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return resourceServiceAccountAPIKeyRead(ctx, d, m)
+}
+
+func resourceServiceAccountAPIKeyRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*Client)
+
+	key, err := client.GetServiceAccountAPIKey(ctx, d.Get("service_account_id").(string), d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if key == nil {
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("key_id", key.KeyID)
+	d.Set("name", key.Name)
+	d.Set("hint", key.Hint)
+	d.Set("enabled", !key.IsDisabled)
+	d.Set("character_count", key.CharacterCount)
+	if key.CharacterLimit != nil {
+		d.Set("character_limit", *key.CharacterLimit)
+	} else {
+		d.Set("character_limit", nil)
+	}
+	if err := d.Set("permissions", []string(key.Permissions)); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+func resourceServiceAccountAPIKeyUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*Client)
+
+	if d.HasChanges("name", "permissions", "character_limit", "enabled") {
+		err := client.UpdateServiceAccountAPIKey(ctx, d.Get("service_account_id").(string), d.Id(), expandServiceAccountAPIKey(d))
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return resourceServiceAccountAPIKeyRead(ctx, d, m)
+}
+
+func resourceServiceAccountAPIKeyDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*Client)
+
+	err := client.DeleteServiceAccountAPIKey(ctx, d.Get("service_account_id").(string), d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId("")
+	return nil
+}
+
+// resourceServiceAccountAPIKeyImport accepts IDs in the form
+// <service_account_id>/<key_id>.
+func resourceServiceAccountAPIKeyImport(ctx context.Context, d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+	parts := strings.SplitN(d.Id(), "/", 2)
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		return nil, fmt.Errorf("unexpected import ID %q, expected <service_account_id>/<key_id>", d.Id())
+	}
+	d.Set("service_account_id", parts[0])
+	d.SetId(parts[1])
+	return []*schema.ResourceData{d}, nil
+}
+
+func expandServiceAccountAPIKey(d *schema.ResourceData) *ServiceAccountAPIKey {
+	enabled := d.Get("enabled").(bool)
+	key := &ServiceAccountAPIKey{
+		Name:        d.Get("name").(string),
+		IsEnabled:   &enabled,
+		Permissions: APIKeyPermissions(expandStringSet(d.Get("permissions"))),
+	}
+	// A missing limit is sent as null, which removes the limit. GetOk cannot
+	// be used as it treats a limit of 0 as not set.
+	if !rawConfigValue(d.GetRawConfig(), "character_limit").IsNull() {
+		limit := d.Get("character_limit").(int)
+		key.CharacterLimit = &limit
+	}
+	return key
+}
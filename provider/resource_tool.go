@@ -4,6 +4,7 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"sort"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -240,6 +241,16 @@ func resourceToolRead(ctx context.Context, d *schema.ResourceData, m interface{}
 		return nil
 	}
 
+	if err := setToolAttributes(d, tool); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+// setToolAttributes sets the attributes shared by the elevenlabs_tool
+// resource and data source from tool.
+func setToolAttributes(d *schema.ResourceData, tool *ToolResponse) error {
 	d.Set("tool_id", tool.ID)
 	d.Set("name", tool.ToolConfig.Name)
 	d.Set("description", tool.ToolConfig.Description)
@@ -249,64 +260,127 @@ func resourceToolRead(ctx context.Context, d *schema.ResourceData, m interface{}
 	d.Set("force_pre_tool_speech", tool.ToolConfig.ForcePreToolSpeech)
 
 	if tool.ToolConfig.APISchema != nil {
-		apiSchema := make(map[string]interface{})
-		apiSchema["url"] = tool.ToolConfig.APISchema.URL
-		apiSchema["method"] = tool.ToolConfig.APISchema.Method
-
-		if tool.ToolConfig.APISchema.PathParamsSchema != nil {
-			pathParamsList := make([]interface{}, 0, len(tool.ToolConfig.APISchema.PathParamsSchema))
-			for k, v := range tool.ToolConfig.APISchema.PathParamsSchema {
-				param := make(map[string]interface{})
-				param["name"] = k
-				param["type"] = v.Type
-				param["description"] = v.Description
-				pathParamsList = append(pathParamsList, param)
-			}
-			apiSchema["path_params_schema"] = pathParamsList
+		var prior map[string]interface{}
+		if v, ok := d.Get("api_schema").([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			prior = v[0].(map[string]interface{})
 		}
+		apiSchema, err := flattenAPISchema(tool.ToolConfig.APISchema, prior)
+		if err != nil {
+			return err
+		}
+		return d.Set("api_schema", []interface{}{apiSchema})
+	}
 
-		if tool.ToolConfig.APISchema.QueryParamsSchema != nil {
-			queryParams := make(map[string]interface{})
-			propsList := make([]interface{}, 0, len(tool.ToolConfig.APISchema.QueryParamsSchema.Properties))
-			for k, v := range tool.ToolConfig.APISchema.QueryParamsSchema.Properties {
-				prop := make(map[string]interface{})
-				prop["name"] = k
-				prop["type"] = v.Type
-				prop["description"] = v.Description
-				propsList = append(propsList, prop)
-			}
-			queryParams["properties"] = propsList
-			queryParams["required"] = tool.ToolConfig.APISchema.QueryParamsSchema.Required
-			apiSchema["query_params_schema"] = []interface{}{queryParams}
+	return nil
+}
+
+// flattenAPISchema converts the API schema of a webhook tool into the
+// api_schema block of elevenlabs_tool. The API returns parameters as
+// unordered maps, so they are listed in the order of prior, the current
+// api_schema block, followed by any new ones sorted by name.
+func flattenAPISchema(api *APISchema, prior map[string]interface{}) (map[string]interface{}, error) {
+	apiSchema := make(map[string]interface{})
+	apiSchema["url"] = api.URL
+	apiSchema["method"] = api.Method
+
+	if api.PathParamsSchema != nil {
+		pathParamsList := make([]interface{}, 0, len(api.PathParamsSchema))
+		for _, k := range orderedPropertyNames(api.PathParamsSchema, prior["path_params_schema"]) {
+			v := api.PathParamsSchema[k]
+			param := make(map[string]interface{})
+			param["name"] = k
+			param["type"] = v.Type
+			param["description"] = v.Description
+			pathParamsList = append(pathParamsList, param)
 		}
+		apiSchema["path_params_schema"] = pathParamsList
+	}
 
-		if tool.ToolConfig.APISchema.RequestHeaders != nil {
-			apiSchema["request_headers"] = tool.ToolConfig.APISchema.RequestHeaders
+	if api.QueryParamsSchema != nil {
+		var priorQueryParams map[string]interface{}
+		if v, ok := prior["query_params_schema"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			priorQueryParams = v[0].(map[string]interface{})
 		}
+		queryParams := make(map[string]interface{})
+		propsList := make([]interface{}, 0, len(api.QueryParamsSchema.Properties))
+		for _, k := range orderedPropertyNames(api.QueryParamsSchema.Properties, priorQueryParams["properties"]) {
+			v := api.QueryParamsSchema.Properties[k]
+			prop := make(map[string]interface{})
+			prop["name"] = k
+			prop["type"] = v.Type
+			prop["description"] = v.Description
+			propsList = append(propsList, prop)
+		}
+		queryParams["properties"] = propsList
+		queryParams["required"] = orderedStrings(api.QueryParamsSchema.Required, priorQueryParams["required"])
+		apiSchema["query_params_schema"] = []interface{}{queryParams}
+	}
 
-		if tool.ToolConfig.APISchema.RequestBodySchema != nil {
-			requestBodySchema, err := json.Marshal(tool.ToolConfig.APISchema.RequestBodySchema)
-			if err != nil {
-				return diag.FromErr(err)
-			}
-			// Unmarshal and then re-marshal to get a compact, canonical JSON string
-			var v interface{}
-			if err := json.Unmarshal(requestBodySchema, &v); err != nil {
-				return diag.FromErr(err)
-			}
-			compactBody, err := json.Marshal(v)
-			if err != nil {
-				return diag.FromErr(err)
-			}
-			apiSchema["request_body_schema"] = string(compactBody)
+	if api.RequestHeaders != nil {
+		apiSchema["request_headers"] = api.RequestHeaders
+	}
+
+	if api.RequestBodySchema != nil {
+		requestBodySchema, err := json.Marshal(api.RequestBodySchema)
+		if err != nil {
+			return nil, err
+		}
+		// Unmarshal and then re-marshal to get a compact, canonical JSON string
+		var v interface{}
+		if err := json.Unmarshal(requestBodySchema, &v); err != nil {
+			return nil, err
+		}
+		compactBody, err := json.Marshal(v)
+		if err != nil {
+			return nil, err
 		}
+		apiSchema["request_body_schema"] = string(compactBody)
+	}
 
-		if err := d.Set("api_schema", []interface{}{apiSchema}); err != nil {
-			return diag.FromErr(err)
+	return apiSchema, nil
+}
+
+// orderedPropertyNames returns the names of properties in the order of the
+// name attributes of the prior parameter blocks, followed by the names the
+// prior blocks lack, sorted.
+func orderedPropertyNames(properties map[string]LiteralJsonSchemaProperty, prior interface{}) []string {
+	var priorNames []interface{}
+	priorList, _ := prior.([]interface{})
+	for _, item := range priorList {
+		if param, ok := item.(map[string]interface{}); ok {
+			priorNames = append(priorNames, param["name"])
 		}
 	}
 
-	return nil
+	names := make([]string, 0, len(properties))
+	for name := range properties {
+		names = append(names, name)
+	}
+	return orderedStrings(names, priorNames)
+}
+
+// orderedStrings returns values in the order of prior, followed by the values
+// prior lacks, sorted.
+func orderedStrings(values []string, prior interface{}) []string {
+	remaining := make(map[string]bool, len(values))
+	for _, v := range values {
+		remaining[v] = true
+	}
+
+	ordered := make([]string, 0, len(values))
+	priorList, _ := prior.([]interface{})
+	for _, item := range priorList {
+		if v, ok := item.(string); ok && remaining[v] {
+			ordered = append(ordered, v)
+			delete(remaining, v)
+		}
+	}
+	rest := make([]string, 0, len(remaining))
+	for v := range remaining {
+		rest = append(rest, v)
+	}
+	sort.Strings(rest)
+	return append(ordered, rest...)
 }
 
 func resourceToolUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
@@ -0,0 +1,91 @@
+package provider
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestFlattenAPISchemaParameterOrder(t *testing.T) {
+	api := &APISchema{
+		URL:    "https://api.example.com/{b}/{a}",
+		Method: "GET",
+		PathParamsSchema: map[string]LiteralJsonSchemaProperty{
+			"a": {Type: "string"},
+			"b": {Type: "string"},
+		},
+		QueryParamsSchema: &QueryParamsJsonSchema{
+			Properties: map[string]LiteralJsonSchemaProperty{
+				"z":   {Type: "string"},
+				"y":   {Type: "integer"},
+				"new": {Type: "boolean"},
+				"x":   {Type: "number"},
+			},
+			Required: []string{"y", "z"},
+		},
+	}
+
+	names := func(v interface{}) []string {
+		var names []string
+		for _, item := range v.([]interface{}) {
+			names = append(names, item.(map[string]interface{})["name"].(string))
+		}
+		return names
+	}
+
+	cases := []struct {
+		name         string
+		prior        map[string]interface{}
+		wantPath     []string
+		wantQuery    []string
+		wantRequired []string
+	}{
+		{
+			name:         "sorted without prior",
+			wantPath:     []string{"a", "b"},
+			wantQuery:    []string{"new", "x", "y", "z"},
+			wantRequired: []string{"y", "z"},
+		},
+		{
+			name: "prior order kept",
+			prior: map[string]interface{}{
+				"path_params_schema": []interface{}{
+					map[string]interface{}{"name": "b"},
+					map[string]interface{}{"name": "a"},
+				},
+				"query_params_schema": []interface{}{
+					map[string]interface{}{
+						"properties": []interface{}{
+							map[string]interface{}{"name": "z"},
+							map[string]interface{}{"name": "removed"},
+							map[string]interface{}{"name": "y"},
+							map[string]interface{}{"name": "x"},
+						},
+						"required": []interface{}{"z", "y"},
+					},
+				},
+			},
+			wantPath:     []string{"b", "a"},
+			wantQuery:    []string{"z", "y", "x", "new"},
+			wantRequired: []string{"z", "y"},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			apiSchema, err := flattenAPISchema(api, tc.prior)
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+			if got := names(apiSchema["path_params_schema"]); !reflect.DeepEqual(got, tc.wantPath) {
+				t.Errorf("expected path parameters %v, got %v", tc.wantPath, got)
+			}
+			queryParams := apiSchema["query_params_schema"].([]interface{})[0].(map[string]interface{})
+			if got := names(queryParams["properties"]); !reflect.DeepEqual(got, tc.wantQuery) {
+				t.Errorf("expected query parameters %v, got %v", tc.wantQuery, got)
+			}
+			if got := queryParams["required"]; !reflect.DeepEqual(got, tc.wantRequired) {
+				t.Errorf("expected required %v, got %v", tc.wantRequired, got)
+			}
+		})
+	}
+}
@@ -0,0 +1,130 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func resourceWhatsAppAccount() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceWhatsAppAccountCreate,
+		ReadContext:   resourceWhatsAppAccountRead,
+		UpdateContext: resourceWhatsAppAccountUpdate,
+		DeleteContext: resourceWhatsAppAccountDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		Description: "Links a WhatsApp Business phone number to an agent. `token_code` is not read back from the API, so after `terraform import` the next plan would replace the account; list `token_code` in `lifecycle.ignore_changes` of an imported account.",
+		Schema: map[string]*schema.Schema{
+			"phone_number_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The WhatsApp Business phone number ID from Meta.",
+			},
+			"business_account_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"token_code": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Sensitive:   true,
+				Description: "The code returned by Meta's embedded signup, exchanged for the account's access token. It is never read back from the API.",
+			},
+			"agent_id": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The agent that answers messages sent to this number. Enable `conversation_config.conversation.text_only` on the agent so it replies in text.",
+			},
+			"business_account_name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"phone_number_name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"phone_number": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceWhatsAppAccountCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*Client)
+	phoneNumberID := d.Get("phone_number_id").(string)
+
+	account := &WhatsAppAccountRequest{
+		BusinessAccountID: d.Get("business_account_id").(string),
+		PhoneNumberID:     phoneNumberID,
+		TokenCode:         d.Get("token_code").(string),
+	}
+	if err := client.CreateWhatsAppAccount(ctx, account); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(phoneNumberID)
+
+	if agentID := d.Get("agent_id").(string); agentID != "" {
+		if err := client.AssignWhatsAppAccountAgent(ctx, phoneNumberID, agentID); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return resourceWhatsAppAccountRead(ctx, d, m)
+}
+
+func resourceWhatsAppAccountRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*Client)
+
+	account, err := client.GetWhatsAppAccount(ctx, d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if account == nil {
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("phone_number_id", account.PhoneNumberID)
+	d.Set("business_account_id", account.BusinessAccountID)
+	d.Set("agent_id", account.AssignedAgentID)
+	d.Set("business_account_name", account.BusinessAccountName)
+	d.Set("phone_number_name", account.PhoneNumberName)
+	d.Set("phone_number", account.PhoneNumber)
+
+	return nil
+}
+
+func resourceWhatsAppAccountUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*Client)
+
+	if d.HasChange("agent_id") {
+		err := client.AssignWhatsAppAccountAgent(ctx, d.Id(), d.Get("agent_id").(string))
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return resourceWhatsAppAccountRead(ctx, d, m)
+}
+
+func resourceWhatsAppAccountDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*Client)
+
+	err := client.DeleteWhatsAppAccount(ctx, d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId("")
+	return nil
+}
@@ -0,0 +1,275 @@
+package provider
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+)
+
+// toolJSONSchemaTypes are the JSON Schema types ElevenLabs accepts in tool
+// parameter schemas.
+var toolJSONSchemaTypes = map[string]bool{
+	"string":  true,
+	"number":  true,
+	"integer": true,
+	"boolean": true,
+	"object":  true,
+	"array":   true,
+}
+
+// parseToolJSONSchema decodes a JSON Schema document and normalizes it with
+// normalizeToolJSONSchema. The root must describe an object, as the request
+// body of a webhook tool does.
+func parseToolJSONSchema(document string) (map[string]interface{}, error) {
+	decoder := json.NewDecoder(strings.NewReader(document))
+	decoder.UseNumber()
+	var schema interface{}
+	if err := decoder.Decode(&schema); err != nil {
+		return nil, fmt.Errorf("invalid JSON: %s", err)
+	}
+	// Decode stops after the first value; anything but whitespace after it
+	// is an error rather than silently ignored.
+	if _, err := decoder.Token(); err != io.EOF {
+		return nil, fmt.Errorf("invalid JSON: unexpected data after the schema")
+	}
+
+	normalized, err := normalizeToolJSONSchema(schema, "", true)
+	if err != nil {
+		return nil, err
+	}
+	if normalized["type"] != "object" {
+		return nil, fmt.Errorf("the root schema must have type \"object\", got %q", normalized["type"])
+	}
+	return normalized, nil
+}
+
+// normalizeToolJSONSchema checks that schema only uses the JSON Schema subset
+// ElevenLabs supports in tool parameters: type, description, enum,
+// properties, required and items. Annotations that ElevenLabs ignores,
+// $schema and title, are dropped. path names the schema in errors.
+func normalizeToolJSONSchema(schema interface{}, path string, root bool) (map[string]interface{}, error) {
+	where := path
+	if where == "" {
+		where = "the root schema"
+	}
+
+	obj, ok := schema.(map[string]interface{})
+	if !ok {
+		return nil, fmt.Errorf("%s must be a JSON object", where)
+	}
+
+	keywords := make([]string, 0, len(obj))
+	for keyword := range obj {
+		keywords = append(keywords, keyword)
+	}
+	sort.Strings(keywords)
+	for _, keyword := range keywords {
+		switch keyword {
+		case "type", "description", "enum", "properties", "required", "items", "title":
+		case "$schema":
+			if !root {
+				return nil, fmt.Errorf("%s: $schema is only allowed in the root schema", where)
+			}
+		case "$ref":
+			return nil, fmt.Errorf("%s: unsupported keyword \"$ref\", inline the referenced schema instead", where)
+		default:
+			return nil, fmt.Errorf("%s: unsupported keyword %q, only type, description, enum, properties, required and items are supported", where, keyword)
+		}
+	}
+
+	if _, ok := obj["type"]; !ok {
+		return nil, fmt.Errorf("%s: type must be set", where)
+	}
+	schemaType, ok := obj["type"].(string)
+	if !ok {
+		return nil, fmt.Errorf("%s: type must be a single type name, lists of types are not supported", where)
+	}
+	if !toolJSONSchemaTypes[schemaType] {
+		return nil, fmt.Errorf("%s: unsupported type %q, expected one of string, number, integer, boolean, object or array", where, schemaType)
+	}
+
+	normalized := map[string]interface{}{"type": schemaType}
+	if description, ok := obj["description"]; ok {
+		s, ok := description.(string)
+		if !ok {
+			return nil, fmt.Errorf("%s: description must be a string", where)
+		}
+		if s != "" {
+			normalized["description"] = s
+		}
+	}
+
+	if enum, ok := obj["enum"]; ok {
+		values, err := normalizeToolJSONSchemaEnum(enum, schemaType, where)
+		if err != nil {
+			return nil, err
+		}
+		normalized["enum"] = values
+	}
+
+	if schemaType != "object" {
+		for _, keyword := range []string{"properties", "required"} {
+			if _, ok := obj[keyword]; ok {
+				return nil, fmt.Errorf("%s: %s is only allowed on objects", where, keyword)
+			}
+		}
+	}
+	if schemaType != "array" {
+		if _, ok := obj["items"]; ok {
+			return nil, fmt.Errorf("%s: items is only allowed on arrays", where)
+		}
+	}
+
+	switch schemaType {
+	case "object":
+		properties := map[string]interface{}{}
+		if v, ok := obj["properties"]; ok {
+			propertyMap, ok := v.(map[string]interface{})
+			if !ok {
+				return nil, fmt.Errorf("%s: properties must be a JSON object", where)
+			}
+			names := make([]string, 0, len(propertyMap))
+			for name := range propertyMap {
+				names = append(names, name)
+			}
+			sort.Strings(names)
+			for _, name := range names {
+				normalizedProperty, err := normalizeToolJSONSchema(propertyMap[name], joinToolJSONSchemaPath(path, "properties."+name), false)
+				if err != nil {
+					return nil, err
+				}
+				properties[name] = normalizedProperty
+			}
+		}
+		normalized["properties"] = properties
+
+		if v, ok := obj["required"]; ok {
+			requiredList, ok := v.([]interface{})
+			if !ok {
+				return nil, fmt.Errorf("%s: required must be a list of property names", where)
+			}
+			required := []string{}
+			for _, r := range requiredList {
+				name, ok := r.(string)
+				if !ok {
+					return nil, fmt.Errorf("%s: required must be a list of property names", where)
+				}
+				if _, ok := properties[name]; !ok {
+					return nil, fmt.Errorf("%s: required property %q is not defined in properties", where, name)
+				}
+				required = append(required, name)
+			}
+			sort.Strings(required)
+			if len(required) > 0 {
+				normalized["required"] = required
+			}
+		}
+	case "array":
+		items, ok := obj["items"]
+		if !ok {
+			return nil, fmt.Errorf("%s: arrays must define items", where)
+		}
+		normalizedItems, err := normalizeToolJSONSchema(items, joinToolJSONSchemaPath(path, "items"), false)
+		if err != nil {
+			return nil, err
+		}
+		normalized["items"] = normalizedItems
+	}
+
+	return normalized, nil
+}
+
+// toolQueryParam is one entry of the query_params_schema.properties block of
+// elevenlabs_tool.
+type toolQueryParam struct {
+	Name        string `tfsdk:"name"`
+	Type        string `tfsdk:"type"`
+	Description string `tfsdk:"description"`
+}
+
+// toolQueryParams converts a schema normalized by normalizeToolJSONSchema into
+// the properties and required names of a query_params_schema block. Query
+// parameters are flat, so every property must have a scalar type, and enums
+// are rejected as the block cannot express them. Properties are sorted by
+// name.
+func toolQueryParams(schema map[string]interface{}) ([]toolQueryParam, []string, error) {
+	properties := schema["properties"].(map[string]interface{})
+	names := make([]string, 0, len(properties))
+	for name := range properties {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	params := []toolQueryParam{}
+	for _, name := range names {
+		property := properties[name].(map[string]interface{})
+		propertyType := property["type"].(string)
+		switch propertyType {
+		case "string", "number", "integer", "boolean":
+		default:
+			return nil, nil, fmt.Errorf("properties.%s: query parameters must be a string, number, integer or boolean, got %q", name, propertyType)
+		}
+		if _, ok := property["enum"]; ok {
+			return nil, nil, fmt.Errorf("properties.%s: enum is not supported in query parameters", name)
+		}
+		description, _ := property["description"].(string)
+		params = append(params, toolQueryParam{
+			Name:        name,
+			Type:        propertyType,
+			Description: description,
+		})
+	}
+
+	required := []string{}
+	if v, ok := schema["required"].([]string); ok {
+		required = v
+	}
+	return params, required, nil
+}
+
+func normalizeToolJSONSchemaEnum(enum interface{}, schemaType, where string) ([]interface{}, error) {
+	values, ok := enum.([]interface{})
+	if !ok || len(values) == 0 {
+		return nil, fmt.Errorf("%s: enum must be a non-empty list", where)
+	}
+	for _, value := range values {
+		valid := false
+		switch schemaType {
+		case "string":
+			_, valid = value.(string)
+		case "number":
+			_, valid = value.(json.Number)
+		case "integer":
+			if n, ok := value.(json.Number); ok {
+				_, err := n.Int64()
+				valid = err == nil
+			}
+		default:
+			return nil, fmt.Errorf("%s: enum is only allowed on strings, numbers and integers", where)
+		}
+		if !valid {
+			return nil, fmt.Errorf("%s: enum value %v is not of type %s", where, value, schemaType)
+		}
+	}
+	return values, nil
+}
+
+func joinToolJSONSchemaPath(path, element string) string {
+	if path == "" {
+		return element
+	}
+	return path + "." + element
+}
+
+// encodeToolJSONSchema returns the compact JSON encoding of a normalized
+// schema, with object keys sorted, matching how elevenlabs_tool stores
+// request_body_schema.
+func encodeToolJSONSchema(schema map[string]interface{}) (string, error) {
+	encoded, err := json.Marshal(schema)
+	if err != nil {
+		return "", err
+	}
+	return string(encoded), nil
+}
@@ -0,0 +1,204 @@
+package provider
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestParseToolJSONSchema(t *testing.T) {
+	cases := []struct {
+		name     string
+		document string
+		want     string
+		wantErr  string
+	}{
+		{
+			name: "normalized",
+			document: `{
+				"$schema": "https://json-schema.org/draft/2020-12/schema",
+				"title": "Order",
+				"type": "object",
+				"required": ["status", "id"],
+				"properties": {
+					"id": {"type": "integer", "description": "The order ID."},
+					"status": {"type": "string", "enum": ["open", "closed"]},
+					"tags": {"type": "array", "items": {"type": "string", "description": ""}}
+				}
+			}`,
+			want: `{"properties":{"id":{"description":"The order ID.","type":"integer"},"status":{"enum":["open","closed"],"type":"string"},"tags":{"items":{"type":"string"},"type":"array"}},"required":["id","status"],"type":"object"}`,
+		},
+		{
+			name:     "trailing data",
+			document: `{"type": "object"} {"type": "object"}`,
+			wantErr:  "unexpected data after the schema",
+		},
+		{
+			name:     "trailing brace",
+			document: `{"type": "object"}}`,
+			wantErr:  "invalid JSON",
+		},
+		{
+			name:     "trailing whitespace",
+			document: "{\"type\": \"object\"}\n\n",
+			want:     `{"properties":{},"type":"object"}`,
+		},
+		{
+			name:     "invalid JSON",
+			document: `{"type": `,
+			wantErr:  "invalid JSON",
+		},
+		{
+			name:     "root is not an object",
+			document: `{"type": "string"}`,
+			wantErr:  `the root schema must have type "object"`,
+		},
+		{
+			name:     "missing type",
+			document: `{"type": "object", "properties": {"id": {"description": "x"}}}`,
+			wantErr:  "properties.id: type must be set",
+		},
+		{
+			name:     "list of types",
+			document: `{"type": ["object", "null"]}`,
+			wantErr:  "lists of types are not supported",
+		},
+		{
+			name:     "unsupported type",
+			document: `{"type": "object", "properties": {"id": {"type": "null"}}}`,
+			wantErr:  `properties.id: unsupported type "null"`,
+		},
+		{
+			name:     "ref",
+			document: `{"type": "object", "properties": {"id": {"$ref": "#/$defs/id"}}}`,
+			wantErr:  `unsupported keyword "$ref"`,
+		},
+		{
+			name:     "unsupported keyword",
+			document: `{"type": "object", "additionalProperties": false}`,
+			wantErr:  `unsupported keyword "additionalProperties"`,
+		},
+		{
+			name:     "nested $schema",
+			document: `{"type": "object", "properties": {"id": {"type": "string", "$schema": "x"}}}`,
+			wantErr:  "$schema is only allowed in the root schema",
+		},
+		{
+			name:     "undefined required property",
+			document: `{"type": "object", "required": ["id"]}`,
+			wantErr:  `required property "id" is not defined`,
+		},
+		{
+			name:     "array without items",
+			document: `{"type": "object", "properties": {"tags": {"type": "array"}}}`,
+			wantErr:  "properties.tags: arrays must define items",
+		},
+		{
+			name:     "items on a string",
+			document: `{"type": "object", "properties": {"id": {"type": "string", "items": {"type": "string"}}}}`,
+			wantErr:  "items is only allowed on arrays",
+		},
+		{
+			name:     "enum of the wrong type",
+			document: `{"type": "object", "properties": {"count": {"type": "integer", "enum": [1, 2.5]}}}`,
+			wantErr:  "enum value 2.5 is not of type integer",
+		},
+		{
+			name:     "empty enum",
+			document: `{"type": "object", "properties": {"id": {"type": "string", "enum": []}}}`,
+			wantErr:  "enum must be a non-empty list",
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			schema, err := parseToolJSONSchema(tc.document)
+			if tc.wantErr != "" {
+				if err == nil || !strings.Contains(err.Error(), tc.wantErr) {
+					t.Fatalf("expected error containing %q, got %v", tc.wantErr, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+			got, err := encodeToolJSONSchema(schema)
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+			if got != tc.want {
+				t.Fatalf("expected %s, got %s", tc.want, got)
+			}
+		})
+	}
+}
+
+func TestToolQueryParams(t *testing.T) {
+	cases := []struct {
+		name         string
+		document     string
+		wantParams   []toolQueryParam
+		wantRequired []string
+		wantErr      string
+	}{
+		{
+			name: "scalar properties",
+			document: `{
+				"type": "object",
+				"required": ["q"],
+				"properties": {
+					"q": {"type": "string", "description": "The search terms."},
+					"limit": {"type": "integer"},
+					"exact": {"type": "boolean"}
+				}
+			}`,
+			wantParams: []toolQueryParam{
+				{Name: "exact", Type: "boolean"},
+				{Name: "limit", Type: "integer"},
+				{Name: "q", Type: "string", Description: "The search terms."},
+			},
+			wantRequired: []string{"q"},
+		},
+		{
+			name:         "no properties",
+			document:     `{"type": "object"}`,
+			wantParams:   []toolQueryParam{},
+			wantRequired: []string{},
+		},
+		{
+			name:     "nested object",
+			document: `{"type": "object", "properties": {"filter": {"type": "object"}}}`,
+			wantErr:  `properties.filter: query parameters must be a string, number, integer or boolean, got "object"`,
+		},
+		{
+			name:     "enum",
+			document: `{"type": "object", "properties": {"sort": {"type": "string", "enum": ["asc", "desc"]}}}`,
+			wantErr:  "properties.sort: enum is not supported in query parameters",
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			schema, err := parseToolJSONSchema(tc.document)
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+			params, required, err := toolQueryParams(schema)
+			if tc.wantErr != "" {
+				if err == nil || !strings.Contains(err.Error(), tc.wantErr) {
+					t.Fatalf("expected error containing %q, got %v", tc.wantErr, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+			if !reflect.DeepEqual(params, tc.wantParams) {
+				t.Errorf("expected properties %#v, got %#v", tc.wantParams, params)
+			}
+			if !reflect.DeepEqual(required, tc.wantRequired) {
+				t.Errorf("expected required %#v, got %#v", tc.wantRequired, required)
+			}
+		})
+	}
+}